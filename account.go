@@ -0,0 +1,67 @@
+package fizzy
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// JoinCode is the account's invitation code. Anyone with its URL can join
+// the account until the usage limit is reached.
+type JoinCode struct {
+	Code       string `json:"code"`
+	UsageCount int    `json:"usage_count"`
+	UsageLimit int    `json:"usage_limit"`
+	URL        string `json:"url"`
+}
+
+// Remaining returns how many more people can join with the code.
+func (j *JoinCode) Remaining() int {
+	return max(0, j.UsageLimit-j.UsageCount)
+}
+
+func (c *Client) GetJoinCode(ctx context.Context) (*JoinCode, error) {
+	endpointURL := c.AccountBaseURL + "/account/join_code"
+
+	req, err := c.newRequest(ctx, http.MethodGet, endpointURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create get join code request: %w", err)
+	}
+
+	var response JoinCode
+	_, err = c.decodeResponse(req, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// SetJoinCodeLimit changes how many people can join with the current code.
+func (c *Client) SetJoinCodeLimit(ctx context.Context, usageLimit int) error {
+	endpointURL := c.AccountBaseURL + "/account/join_code"
+
+	body := map[string]map[string]int{"account_join_code": {"usage_limit": usageLimit}}
+
+	req, err := c.newRequest(ctx, http.MethodPut, endpointURL, body)
+	if err != nil {
+		return fmt.Errorf("failed to create update join code request: %w", err)
+	}
+
+	_, err = c.decodeResponse(req, nil, http.StatusNoContent)
+	return err
+}
+
+// ResetJoinCode replaces the join code with a new one, invalidating links
+// already shared.
+func (c *Client) ResetJoinCode(ctx context.Context) error {
+	endpointURL := c.AccountBaseURL + "/account/join_code"
+
+	req, err := c.newRequest(ctx, http.MethodDelete, endpointURL, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create reset join code request: %w", err)
+	}
+
+	_, err = c.decodeResponse(req, nil, http.StatusNoContent)
+	return err
+}
@@ -0,0 +1,70 @@
+package fizzy
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGetJoinCode(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/test-account/account/join_code" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		json.NewEncoder(w).Encode(JoinCode{Code: "abc123", UsageCount: 3, UsageLimit: 10})
+	}))
+	defer server.Close()
+
+	client, _ := NewClient("/test-account", "test-token", WithBaseURL(server.URL))
+	code, err := client.GetJoinCode(context.Background())
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if code.Code != "abc123" || code.Remaining() != 7 {
+		t.Errorf("unexpected join code: %+v", code)
+	}
+}
+
+func TestSetJoinCodeLimit(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut {
+			t.Errorf("expected PUT, got %s", r.Method)
+		}
+
+		var body map[string]map[string]int
+		json.NewDecoder(r.Body).Decode(&body)
+		if body["account_join_code"]["usage_limit"] != 25 {
+			t.Errorf("expected usage_limit 25, got %v", body)
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client, _ := NewClient("/test-account", "test-token", WithBaseURL(server.URL))
+	err := client.SetJoinCodeLimit(context.Background(), 25)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestResetJoinCode(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete {
+			t.Errorf("expected DELETE, got %s", r.Method)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client, _ := NewClient("/test-account", "test-token", WithBaseURL(server.URL))
+	err := client.ResetJoinCode(context.Background())
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
@@ -0,0 +1,310 @@
+// Package analytics computes flow metrics from cards and their activity.
+//
+// Lead time runs from a card's creation to its closure and cycle time from
+// when work started, the first time the card was placed in a column, to its
+// closure. Throughput counts cards closed per week, WIP counts open cards per
+// column and aging WIP lists how long each open card has been in progress:
+//
+//	cards, events, err := analytics.Collect(ctx, client, []string{boardID}, since)
+//	report := analytics.Analyze(cards, events, time.Now())
+//	report.WriteJSON(os.Stdout)
+package analytics
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"slices"
+	"sort"
+	"time"
+
+	fizzy "github.com/rogeriopvl/fizzy-go"
+)
+
+// Timeline holds the points in a card's life the metrics are computed from.
+// Started and Closed are zero if the card never reached them.
+type Timeline struct {
+	Card    fizzy.Card
+	Created time.Time
+	Started time.Time
+	Closed  time.Time
+}
+
+// LeadTime returns the time from creation to closure, and false for open
+// cards.
+func (t Timeline) LeadTime() (time.Duration, bool) {
+	if t.Closed.IsZero() || t.Created.IsZero() {
+		return 0, false
+	}
+	return t.Closed.Sub(t.Created), true
+}
+
+// CycleTime returns the time from the start of work to closure, and false
+// for open cards or cards closed straight from triage.
+func (t Timeline) CycleTime() (time.Duration, bool) {
+	if t.Closed.IsZero() || t.Started.IsZero() {
+		return 0, false
+	}
+	return t.Closed.Sub(t.Started), true
+}
+
+// Timelines derives each card's timeline. Work is taken to start at the
+// card's earliest move into a column in events; closure comes from the
+// card's ClosedAt or, failing that, its latest close event.
+func Timelines(cards []fizzy.Card, events []fizzy.Event) []Timeline {
+	started := make(map[string]time.Time)
+	closed := make(map[string]time.Time)
+	for _, event := range events {
+		info := event.Info()
+		at := info.Time()
+		switch e := event.(type) {
+		case *fizzy.CardMoved:
+			if e.Column == "" {
+				continue
+			}
+			if first, ok := started[info.Card.ID]; !ok || at.Before(first) {
+				started[info.Card.ID] = at
+			}
+		case *fizzy.CardClosed:
+			if at.After(closed[info.Card.ID]) {
+				closed[info.Card.ID] = at
+			}
+		}
+	}
+
+	timelines := make([]Timeline, 0, len(cards))
+	for _, card := range cards {
+		t := Timeline{
+			Card:    card,
+			Created: parseTime(card.CreatedAt),
+			Started: started[card.ID],
+		}
+		if card.Closed {
+			t.Closed = parseTime(card.ClosedAt)
+			if t.Closed.IsZero() {
+				t.Closed = closed[card.ID]
+			}
+		}
+		timelines = append(timelines, t)
+	}
+	return timelines
+}
+
+// Summary describes a set of durations.
+type Summary struct {
+	Count int
+	Mean  time.Duration
+	P50   time.Duration
+	P85   time.Duration
+	P95   time.Duration
+	Max   time.Duration
+}
+
+// Summarize computes the count, mean, 50th, 85th and 95th percentiles and
+// maximum of durations.
+func Summarize(durations []time.Duration) Summary {
+	if len(durations) == 0 {
+		return Summary{}
+	}
+
+	sorted := slices.Clone(durations)
+	slices.Sort(sorted)
+
+	var total time.Duration
+	for _, d := range sorted {
+		total += d
+	}
+
+	return Summary{
+		Count: len(sorted),
+		Mean:  total / time.Duration(len(sorted)),
+		P50:   percentile(sorted, 50),
+		P85:   percentile(sorted, 85),
+		P95:   percentile(sorted, 95),
+		Max:   sorted[len(sorted)-1],
+	}
+}
+
+// Percentile returns the p-th percentile (0-100) of durations using the
+// nearest-rank method.
+func Percentile(durations []time.Duration, p float64) time.Duration {
+	if len(durations) == 0 {
+		return 0
+	}
+	sorted := slices.Clone(durations)
+	slices.Sort(sorted)
+	return percentile(sorted, p)
+}
+
+func percentile(sorted []time.Duration, p float64) time.Duration {
+	rank := int(math.Ceil(p / 100 * float64(len(sorted))))
+	return sorted[max(0, min(rank-1, len(sorted)-1))]
+}
+
+// WeeklyCount is the number of cards closed in the week starting Week
+// (a Monday, in UTC).
+type WeeklyCount struct {
+	Week   time.Time
+	Closed int
+}
+
+// ColumnCount is the number of open cards in a column.
+type ColumnCount struct {
+	Column string
+	Cards  int
+}
+
+// AgingCard is an open card in progress and how long it has been so.
+type AgingCard struct {
+	Number int
+	Title  string
+	Column string
+	Age    time.Duration
+}
+
+// Report holds every metric for a set of cards.
+type Report struct {
+	LeadTime   Summary
+	CycleTime  Summary
+	Throughput []WeeklyCount
+	WIP        []ColumnCount
+	Aging      []AgingCard
+}
+
+// Analyze computes all metrics, measuring the age of open cards at now.
+func Analyze(cards []fizzy.Card, events []fizzy.Event, now time.Time) *Report {
+	timelines := Timelines(cards, events)
+
+	var leadTimes, cycleTimes []time.Duration
+	for _, t := range timelines {
+		if d, ok := t.LeadTime(); ok {
+			leadTimes = append(leadTimes, d)
+		}
+		if d, ok := t.CycleTime(); ok {
+			cycleTimes = append(cycleTimes, d)
+		}
+	}
+
+	return &Report{
+		LeadTime:   Summarize(leadTimes),
+		CycleTime:  Summarize(cycleTimes),
+		Throughput: Throughput(timelines),
+		WIP:        WIP(cards),
+		Aging:      Aging(timelines, now),
+	}
+}
+
+// Throughput counts closed cards per week, including weeks with none
+// between the first and last closure.
+func Throughput(timelines []Timeline) []WeeklyCount {
+	counts := make(map[time.Time]int)
+	var first, last time.Time
+	for _, t := range timelines {
+		if t.Closed.IsZero() {
+			continue
+		}
+		week := weekStart(t.Closed)
+		counts[week]++
+		if first.IsZero() || week.Before(first) {
+			first = week
+		}
+		if week.After(last) {
+			last = week
+		}
+	}
+	if first.IsZero() {
+		return nil
+	}
+
+	var weeks []WeeklyCount
+	for week := first; !week.After(last); week = week.AddDate(0, 0, 7) {
+		weeks = append(weeks, WeeklyCount{Week: week, Closed: counts[week]})
+	}
+	return weeks
+}
+
+// WIP counts open cards in each column, in order of first appearance.
+// Cards awaiting triage are not in progress and are left out.
+func WIP(cards []fizzy.Card) []ColumnCount {
+	var wip []ColumnCount
+	index := make(map[string]int)
+	for _, card := range cards {
+		if card.Closed || card.Column == nil {
+			continue
+		}
+		i, ok := index[card.Column.Name]
+		if !ok {
+			i = len(wip)
+			index[card.Column.Name] = i
+			wip = append(wip, ColumnCount{Column: card.Column.Name})
+		}
+		wip[i].Cards++
+	}
+	return wip
+}
+
+// Aging lists open cards that have started, oldest first.
+func Aging(timelines []Timeline, now time.Time) []AgingCard {
+	var aging []AgingCard
+	for _, t := range timelines {
+		if !t.Closed.IsZero() || t.Card.Closed || t.Started.IsZero() {
+			continue
+		}
+		card := AgingCard{Number: t.Card.Number, Title: t.Card.Title, Age: now.Sub(t.Started)}
+		if t.Card.Column != nil {
+			card.Column = t.Card.Column.Name
+		}
+		aging = append(aging, card)
+	}
+	sort.SliceStable(aging, func(i, j int) bool { return aging[i].Age > aging[j].Age })
+	return aging
+}
+
+// Collect fetches the open and closed cards of the given boards (every board
+// when none are given) and their activity since the given time. The card
+// listing does not say whether a card is closed, so cards in the closed
+// index are marked Closed.
+func Collect(ctx context.Context, client *fizzy.Client, boardIDs []string, since time.Time) ([]fizzy.Card, []fizzy.Event, error) {
+	seen := make(map[string]int)
+	var cards []fizzy.Card
+	for _, index := range []string{"all", "closed"} {
+		page, err := client.GetCards(ctx, fizzy.CardFilters{BoardIDs: boardIDs, IndexedBy: index})
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to list %s cards: %w", index, err)
+		}
+		for _, card := range page {
+			i, ok := seen[card.ID]
+			if !ok {
+				i = len(cards)
+				seen[card.ID] = i
+				cards = append(cards, card)
+			}
+			if index == "closed" {
+				cards[i].Closed = true
+			}
+		}
+	}
+
+	events, err := client.GetEvents(ctx, fizzy.EventFilters{
+		BoardIDs: boardIDs,
+		Actions:  []string{fizzy.ActionCardTriaged, fizzy.ActionCardClosed},
+		Since:    since,
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list events: %w", err)
+	}
+
+	return cards, events, nil
+}
+
+func weekStart(t time.Time) time.Time {
+	t = t.UTC()
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	offset := (int(day.Weekday()) + 6) % 7 // days since Monday
+	return day.AddDate(0, 0, -offset)
+}
+
+func parseTime(s string) time.Time {
+	t, _ := time.Parse(time.RFC3339Nano, s)
+	return t
+}
@@ -0,0 +1,159 @@
+package analytics
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	fizzy "github.com/rogeriopvl/fizzy-go"
+)
+
+func moved(cardID, at, column string) fizzy.Event {
+	return &fizzy.CardMoved{EventInfo: fizzy.EventInfo{Card: fizzy.CardReference{ID: cardID}, CreatedAt: at}, Column: column}
+}
+
+func closed(cardID, at string) fizzy.Event {
+	return &fizzy.CardClosed{EventInfo: fizzy.EventInfo{Card: fizzy.CardReference{ID: cardID}, CreatedAt: at}}
+}
+
+var (
+	doing  = &fizzy.Column{Name: "Doing"}
+	review = &fizzy.Column{Name: "Review"}
+
+	testCards = []fizzy.Card{
+		{ID: "c1", Number: 1, Title: "Done quickly", CreatedAt: "2025-12-01T00:00:00Z", Closed: true, ClosedAt: "2025-12-03T00:00:00Z"},
+		{ID: "c2", Number: 2, Title: "Done slowly", CreatedAt: "2025-12-01T00:00:00Z", Closed: true},
+		{ID: "c3", Number: 3, Title: "In progress", CreatedAt: "2025-12-05T00:00:00Z", Column: doing},
+		{ID: "c4", Number: 4, Title: "In review", CreatedAt: "2025-12-06T00:00:00Z", Column: review},
+		{ID: "c5", Number: 5, Title: "Untriaged", CreatedAt: "2025-12-07T00:00:00Z"},
+	}
+
+	testEvents = []fizzy.Event{
+		moved("c1", "2025-12-02T00:00:00Z", "Doing"),
+		moved("c1", "2025-12-01T12:00:00Z", "Doing"),
+		moved("c2", "2025-12-02T00:00:00Z", "Doing"),
+		closed("c2", "2025-12-16T00:00:00Z"),
+		moved("c3", "2025-12-10T00:00:00Z", ""),
+		moved("c3", "2025-12-06T00:00:00Z", "Doing"),
+		moved("c4", "2025-12-08T00:00:00Z", "Review"),
+	}
+)
+
+func TestTimelines(t *testing.T) {
+	timelines := Timelines(testCards, testEvents)
+
+	if lead, ok := timelines[0].LeadTime(); !ok || lead != 48*time.Hour {
+		t.Errorf("expected lead time 48h, got %v", lead)
+	}
+	if cycle, ok := timelines[0].CycleTime(); !ok || cycle != 36*time.Hour {
+		t.Errorf("expected cycle time from earliest move, got %v", cycle)
+	}
+	if lead, ok := timelines[1].LeadTime(); !ok || lead != 15*24*time.Hour {
+		t.Errorf("expected closure from events, got %v", lead)
+	}
+	if _, ok := timelines[2].LeadTime(); ok {
+		t.Error("expected no lead time for open card")
+	}
+	if timelines[4].Started != (time.Time{}) {
+		t.Errorf("expected untriaged card not to have started, got %v", timelines[4].Started)
+	}
+}
+
+func TestSummarize(t *testing.T) {
+	var durations []time.Duration
+	for i := 10; i >= 1; i-- {
+		durations = append(durations, time.Duration(i)*time.Hour)
+	}
+
+	s := Summarize(durations)
+
+	if s.Count != 10 || s.Mean != 5*time.Hour+30*time.Minute {
+		t.Errorf("unexpected count or mean: %+v", s)
+	}
+	if s.P50 != 5*time.Hour || s.P85 != 9*time.Hour || s.P95 != 10*time.Hour || s.Max != 10*time.Hour {
+		t.Errorf("unexpected percentiles: %+v", s)
+	}
+	if durations[0] != 10*time.Hour {
+		t.Error("expected input to be left unsorted")
+	}
+	if Summarize(nil) != (Summary{}) || Percentile(nil, 50) != 0 {
+		t.Error("expected zero summary for no durations")
+	}
+}
+
+func TestAnalyze(t *testing.T) {
+	now := time.Date(2025, 12, 10, 0, 0, 0, 0, time.UTC)
+	report := Analyze(testCards, testEvents, now)
+
+	if report.LeadTime.Count != 2 || report.CycleTime.Count != 2 {
+		t.Errorf("unexpected summaries: %+v %+v", report.LeadTime, report.CycleTime)
+	}
+
+	// Dec 3 falls in the week of Monday Dec 1, Dec 16 in that of Dec 15.
+	expectedWeeks := []struct {
+		week   string
+		closed int
+	}{{"2025-12-01", 1}, {"2025-12-08", 0}, {"2025-12-15", 1}}
+	if len(report.Throughput) != len(expectedWeeks) {
+		t.Fatalf("unexpected throughput: %+v", report.Throughput)
+	}
+	for i, expected := range expectedWeeks {
+		got := report.Throughput[i]
+		if got.Week.Format(time.DateOnly) != expected.week || got.Closed != expected.closed {
+			t.Errorf("expected week %s with %d, got %+v", expected.week, expected.closed, got)
+		}
+	}
+
+	if len(report.WIP) != 2 || report.WIP[0] != (ColumnCount{"Doing", 1}) || report.WIP[1] != (ColumnCount{"Review", 1}) {
+		t.Errorf("unexpected WIP: %+v", report.WIP)
+	}
+
+	if len(report.Aging) != 2 || report.Aging[0].Number != 3 || report.Aging[0].Age != 4*24*time.Hour {
+		t.Errorf("unexpected aging: %+v", report.Aging)
+	}
+}
+
+func TestCollect(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /test-account/cards", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("board_ids[]") != "board-1" {
+			t.Errorf("expected board filter, got %s", r.URL.RawQuery)
+		}
+		switch r.URL.Query().Get("indexed_by") {
+		case "all":
+			json.NewEncoder(w).Encode([]fizzy.Card{{ID: "c1"}, {ID: "c2"}})
+		case "closed":
+			json.NewEncoder(w).Encode([]fizzy.Card{{ID: "c2"}, {ID: "c3"}})
+		}
+	})
+	mux.HandleFunc("GET /test-account/events", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[{"id": "e1", "action": "card_closed", "created_at": "2025-12-02T00:00:00Z", "board": {"id": "board-1"}, "card": {"id": "c3"}}]`)
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	client, _ := fizzy.NewClient("/test-account", "test-token", fizzy.WithBaseURL(server.URL))
+	cards, events, err := Collect(context.Background(), client, []string{"board-1"}, time.Date(2025, 12, 1, 0, 0, 0, 0, time.UTC))
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(cards) != 3 {
+		t.Fatalf("expected 3 distinct cards, got %d", len(cards))
+	}
+	if cards[0].Closed || !cards[1].Closed || !cards[2].Closed {
+		t.Errorf("expected cards from the closed index to be closed, got %+v", cards)
+	}
+	if len(events) != 1 {
+		t.Errorf("expected 1 event, got %d", len(events))
+	}
+
+	report := Analyze(cards, events, time.Date(2025, 12, 10, 0, 0, 0, 0, time.UTC))
+	if report.LeadTime.Count != 0 || len(report.Throughput) != 1 || report.Throughput[0].Closed != 1 {
+		t.Errorf("expected c3's close event to count towards throughput, got %+v", report)
+	}
+}
@@ -0,0 +1,94 @@
+package analytics
+
+import (
+	"encoding/json"
+	"io"
+	"slices"
+	"time"
+)
+
+// Series is a set of named values sampled at common times, such as the bands
+// of a cumulative flow diagram or the lines of a burn-up chart.
+type Series struct {
+	Times []time.Time
+	Lines []Line
+}
+
+// Line is one named series of values, one per entry in Series.Times.
+type Line struct {
+	Name   string
+	Values []int
+}
+
+// CumulativeFlow turns snapshots into the bands of a cumulative flow diagram,
+// bottom to top: Closed, the board's columns from last to first, Triage and
+// Not Now. Columns are matched by name across snapshots, in the order of the
+// most recent snapshot, so renamed or removed columns get their own bands.
+func CumulativeFlow(snapshots []Snapshot) *Series {
+	var columns []string
+	for i := len(snapshots) - 1; i >= 0; i-- {
+		for _, column := range snapshots[i].Columns {
+			if !slices.Contains(columns, column.Name) {
+				columns = append(columns, column.Name)
+			}
+		}
+	}
+
+	names := []string{"Closed"}
+	for i := len(columns) - 1; i >= 0; i-- {
+		names = append(names, columns[i])
+	}
+	names = append(names, "Triage", "Not Now")
+
+	series := &Series{Times: make([]time.Time, len(snapshots)), Lines: make([]Line, len(names))}
+	for i, name := range names {
+		series.Lines[i] = Line{Name: name, Values: make([]int, len(snapshots))}
+	}
+
+	for t, snapshot := range snapshots {
+		series.Times[t] = snapshot.At
+		counts := map[string]int{"Closed": snapshot.Closed, "Triage": snapshot.Triage, "Not Now": snapshot.NotNow}
+		for _, column := range snapshot.Columns {
+			counts[column.Name] += column.Cards
+		}
+		for i := range series.Lines {
+			series.Lines[i].Values[t] = counts[series.Lines[i].Name]
+		}
+	}
+
+	return series
+}
+
+// BurnUp turns snapshots into a burn-up chart: the total scope of the board
+// and how much of it is closed.
+func BurnUp(snapshots []Snapshot) *Series {
+	series := &Series{
+		Times: make([]time.Time, len(snapshots)),
+		Lines: []Line{
+			{Name: "Scope", Values: make([]int, len(snapshots))},
+			{Name: "Closed", Values: make([]int, len(snapshots))},
+		},
+	}
+	for t, snapshot := range snapshots {
+		series.Times[t] = snapshot.At
+		series.Lines[0].Values[t] = snapshot.Total()
+		series.Lines[1].Values[t] = snapshot.Closed
+	}
+	return series
+}
+
+// WriteJSON writes the series as an array of rows, one per sample, keyed by
+// line name plus "time", the shape Grafana's JSON data sources read:
+//
+//	[{"time": "2025-12-01T00:00:00Z", "Closed": 4, "Doing": 3}]
+func (s *Series) WriteJSON(w io.Writer) error {
+	rows := make([]map[string]any, len(s.Times))
+	for t, at := range s.Times {
+		row := map[string]any{"time": at.UTC().Format(time.RFC3339)}
+		for _, line := range s.Lines {
+			row[line.Name] = line.Values[t]
+		}
+		rows[t] = row
+	}
+	return json.NewEncoder(w).Encode(rows)
+}
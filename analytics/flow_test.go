@@ -0,0 +1,75 @@
+package analytics
+
+import (
+	"bytes"
+	"encoding/json"
+	"slices"
+	"testing"
+	"time"
+)
+
+var testSnapshots = []Snapshot{
+	{
+		At:      time.Date(2025, 12, 1, 0, 0, 0, 0, time.UTC),
+		Triage:  3,
+		Columns: []ColumnSnapshot{{Name: "Doing", Cards: 2}},
+		NotNow:  1,
+	},
+	{
+		At:      time.Date(2025, 12, 2, 0, 0, 0, 0, time.UTC),
+		Triage:  2,
+		Columns: []ColumnSnapshot{{Name: "Doing", Cards: 2}, {Name: "Review", Cards: 1}},
+		NotNow:  1,
+		Closed:  2,
+	},
+}
+
+func TestCumulativeFlow(t *testing.T) {
+	series := CumulativeFlow(testSnapshots)
+
+	var names []string
+	for _, line := range series.Lines {
+		names = append(names, line.Name)
+	}
+	if want := []string{"Closed", "Review", "Doing", "Triage", "Not Now"}; !slices.Equal(names, want) {
+		t.Fatalf("expected bands %v, got %v", want, names)
+	}
+	if len(series.Times) != 2 || !series.Times[1].Equal(testSnapshots[1].At) {
+		t.Errorf("unexpected times: %v", series.Times)
+	}
+	if !slices.Equal(series.Lines[1].Values, []int{0, 1}) {
+		t.Errorf("expected Review to be missing from the first snapshot, got %v", series.Lines[1].Values)
+	}
+	if !slices.Equal(series.Lines[3].Values, []int{3, 2}) {
+		t.Errorf("unexpected triage values: %v", series.Lines[3].Values)
+	}
+}
+
+func TestBurnUp(t *testing.T) {
+	series := BurnUp(testSnapshots)
+
+	if series.Lines[0].Name != "Scope" || !slices.Equal(series.Lines[0].Values, []int{6, 8}) {
+		t.Errorf("unexpected scope: %+v", series.Lines[0])
+	}
+	if series.Lines[1].Name != "Closed" || !slices.Equal(series.Lines[1].Values, []int{0, 2}) {
+		t.Errorf("unexpected closed: %+v", series.Lines[1])
+	}
+}
+
+func TestSeriesWriteJSON(t *testing.T) {
+	var buf bytes.Buffer
+	if err := BurnUp(testSnapshots).WriteJSON(&buf); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var rows []map[string]any
+	if err := json.Unmarshal(buf.Bytes(), &rows); err != nil {
+		t.Fatalf("invalid JSON: %v\n%s", err, buf.String())
+	}
+	if len(rows) != 2 {
+		t.Fatalf("expected 2 rows, got %d", len(rows))
+	}
+	if rows[1]["time"] != "2025-12-02T00:00:00Z" || rows[1]["Scope"] != float64(8) || rows[1]["Closed"] != float64(2) {
+		t.Errorf("unexpected row: %v", rows[1])
+	}
+}
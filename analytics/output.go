@@ -0,0 +1,112 @@
+package analytics
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"strconv"
+	"time"
+)
+
+// Durations are written as hours, the unit flow metrics are usually read in.
+func hours(d time.Duration) float64 {
+	return float64(d.Round(time.Minute)) / float64(time.Hour)
+}
+
+func formatHours(d time.Duration) string {
+	return strconv.FormatFloat(hours(d), 'f', 2, 64)
+}
+
+type summaryJSON struct {
+	Count     int     `json:"count"`
+	MeanHours float64 `json:"mean_hours"`
+	P50Hours  float64 `json:"p50_hours"`
+	P85Hours  float64 `json:"p85_hours"`
+	P95Hours  float64 `json:"p95_hours"`
+	MaxHours  float64 `json:"max_hours"`
+}
+
+func (s Summary) MarshalJSON() ([]byte, error) {
+	return json.Marshal(summaryJSON{
+		Count:     s.Count,
+		MeanHours: hours(s.Mean),
+		P50Hours:  hours(s.P50),
+		P85Hours:  hours(s.P85),
+		P95Hours:  hours(s.P95),
+		MaxHours:  hours(s.Max),
+	})
+}
+
+func (w WeeklyCount) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Week   string `json:"week"`
+		Closed int    `json:"closed"`
+	}{w.Week.Format(time.DateOnly), w.Closed})
+}
+
+func (c ColumnCount) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Column string `json:"column"`
+		Cards  int    `json:"cards"`
+	}{c.Column, c.Cards})
+}
+
+func (a AgingCard) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Number   int     `json:"number"`
+		Title    string  `json:"title"`
+		Column   string  `json:"column,omitempty"`
+		AgeHours float64 `json:"age_hours"`
+	}{a.Number, a.Title, a.Column, hours(a.Age)})
+}
+
+// WriteJSON writes the report as an indented JSON object with lead_time,
+// cycle_time, throughput, wip and aging keys.
+func (r *Report) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(struct {
+		LeadTime   Summary       `json:"lead_time"`
+		CycleTime  Summary       `json:"cycle_time"`
+		Throughput []WeeklyCount `json:"throughput"`
+		WIP        []ColumnCount `json:"wip"`
+		Aging      []AgingCard   `json:"aging"`
+	}{r.LeadTime, r.CycleTime, r.Throughput, r.WIP, r.Aging})
+}
+
+// WriteCSV writes the report in long form, one metric,key,value row per
+// data point, which spreadsheets can pivot:
+//
+//	metric,key,value
+//	lead_time,p50_hours,36.00
+//	throughput,2025-12-01,4
+//	wip,Doing,3
+//	aging,#42 Fix login,120.50
+func (r *Report) WriteCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	cw.Write([]string{"metric", "key", "value"})
+
+	for _, s := range []struct {
+		metric  string
+		summary Summary
+	}{{"lead_time", r.LeadTime}, {"cycle_time", r.CycleTime}} {
+		cw.Write([]string{s.metric, "count", strconv.Itoa(s.summary.Count)})
+		cw.Write([]string{s.metric, "mean_hours", formatHours(s.summary.Mean)})
+		cw.Write([]string{s.metric, "p50_hours", formatHours(s.summary.P50)})
+		cw.Write([]string{s.metric, "p85_hours", formatHours(s.summary.P85)})
+		cw.Write([]string{s.metric, "p95_hours", formatHours(s.summary.P95)})
+		cw.Write([]string{s.metric, "max_hours", formatHours(s.summary.Max)})
+	}
+	for _, week := range r.Throughput {
+		cw.Write([]string{"throughput", week.Week.Format(time.DateOnly), strconv.Itoa(week.Closed)})
+	}
+	for _, column := range r.WIP {
+		cw.Write([]string{"wip", column.Column, strconv.Itoa(column.Cards)})
+	}
+	for _, card := range r.Aging {
+		cw.Write([]string{"aging", "#" + strconv.Itoa(card.Number) + " " + card.Title, formatHours(card.Age)})
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
@@ -0,0 +1,72 @@
+package analytics
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+)
+
+var testReport = &Report{
+	LeadTime:   Summary{Count: 2, Mean: 36 * time.Hour, P50: 24 * time.Hour, P85: 48 * time.Hour, P95: 48 * time.Hour, Max: 48 * time.Hour},
+	Throughput: []WeeklyCount{{Week: time.Date(2025, 12, 1, 0, 0, 0, 0, time.UTC), Closed: 4}},
+	WIP:        []ColumnCount{{Column: "Doing", Cards: 3}},
+	Aging:      []AgingCard{{Number: 42, Title: "Fix login", Column: "Doing", Age: 90 * time.Minute}},
+}
+
+func TestWriteJSON(t *testing.T) {
+	var buf bytes.Buffer
+	if err := testReport.WriteJSON(&buf); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var decoded struct {
+		LeadTime struct {
+			Count    int     `json:"count"`
+			P50Hours float64 `json:"p50_hours"`
+		} `json:"lead_time"`
+		Throughput []struct {
+			Week   string `json:"week"`
+			Closed int    `json:"closed"`
+		} `json:"throughput"`
+		Aging []struct {
+			Number   int     `json:"number"`
+			AgeHours float64 `json:"age_hours"`
+		} `json:"aging"`
+	}
+	if err := json.Unmarshal(buf.Bytes(), &decoded); err != nil {
+		t.Fatalf("invalid JSON: %v\n%s", err, buf.String())
+	}
+
+	if decoded.LeadTime.Count != 2 || decoded.LeadTime.P50Hours != 24 {
+		t.Errorf("unexpected lead time: %+v", decoded.LeadTime)
+	}
+	if len(decoded.Throughput) != 1 || decoded.Throughput[0].Week != "2025-12-01" || decoded.Throughput[0].Closed != 4 {
+		t.Errorf("unexpected throughput: %+v", decoded.Throughput)
+	}
+	if len(decoded.Aging) != 1 || decoded.Aging[0].AgeHours != 1.5 {
+		t.Errorf("unexpected aging: %+v", decoded.Aging)
+	}
+}
+
+func TestWriteCSV(t *testing.T) {
+	var buf bytes.Buffer
+	if err := testReport.WriteCSV(&buf); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	out := buf.String()
+	for _, row := range []string{
+		"metric,key,value\n",
+		"lead_time,p50_hours,24.00\n",
+		"cycle_time,count,0\n",
+		"throughput,2025-12-01,4\n",
+		"wip,Doing,3\n",
+		"aging,#42 Fix login,1.50\n",
+	} {
+		if !strings.Contains(out, row) {
+			t.Errorf("expected row %q in:\n%s", row, out)
+		}
+	}
+}
@@ -0,0 +1,168 @@
+package analytics
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	fizzy "github.com/rogeriopvl/fizzy-go"
+)
+
+// Snapshot records how many cards a board had in each state at one moment.
+// Taking snapshots periodically, say daily from cron, builds the history
+// cumulative flow and burn-up charts are drawn from.
+type Snapshot struct {
+	BoardID string           `json:"board_id"`
+	At      time.Time        `json:"at"`
+	Triage  int              `json:"triage"`
+	Columns []ColumnSnapshot `json:"columns"`
+	NotNow  int              `json:"not_now"`
+	Closed  int              `json:"closed"`
+}
+
+// ColumnSnapshot is the number of open cards in a column.
+type ColumnSnapshot struct {
+	ID    string `json:"id"`
+	Name  string `json:"name"`
+	Cards int    `json:"cards"`
+}
+
+// Total returns the number of cards on the board in any state.
+func (s *Snapshot) Total() int {
+	total := s.Triage + s.NotNow + s.Closed
+	for _, column := range s.Columns {
+		total += column.Cards
+	}
+	return total
+}
+
+// TakeSnapshot counts the cards of a board per column, in triage, in Not Now
+// and closed.
+func TakeSnapshot(ctx context.Context, client *fizzy.Client, boardID string) (*Snapshot, error) {
+	columns, err := client.ForBoard(boardID).GetColumns(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list columns: %w", err)
+	}
+
+	snapshot := &Snapshot{BoardID: boardID, At: time.Now().UTC(), Columns: make([]ColumnSnapshot, len(columns))}
+	index := make(map[string]int, len(columns))
+	for i, column := range columns {
+		snapshot.Columns[i] = ColumnSnapshot{ID: column.ID, Name: column.Name}
+		index[column.ID] = i
+	}
+
+	// Cards in Not Now or closed are counted by their own indexes and left
+	// out of the rest, which the listing cannot tell apart on its own.
+	elsewhere := make(map[string]bool)
+	for _, indexedBy := range []string{"not_now", "closed", "all"} {
+		cards, err := client.GetCards(ctx, fizzy.CardFilters{BoardIDs: []string{boardID}, IndexedBy: indexedBy})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list %s cards: %w", indexedBy, err)
+		}
+
+		switch indexedBy {
+		case "not_now", "closed":
+			if indexedBy == "not_now" {
+				snapshot.NotNow = len(cards)
+			} else {
+				snapshot.Closed = len(cards)
+			}
+			for _, card := range cards {
+				elsewhere[card.ID] = true
+			}
+		default:
+			for _, card := range cards {
+				if elsewhere[card.ID] {
+					continue
+				}
+				if card.Column == nil {
+					snapshot.Triage++
+				} else if i, ok := index[card.Column.ID]; ok {
+					snapshot.Columns[i].Cards++
+				}
+			}
+		}
+	}
+
+	return snapshot, nil
+}
+
+// SnapshotStore persists snapshots.
+type SnapshotStore interface {
+	Save(snapshot *Snapshot) error
+	// Load returns a board's snapshots taken in [from, to), oldest first. Zero
+	// times leave that end of the range open.
+	Load(boardID string, from, to time.Time) ([]Snapshot, error)
+}
+
+// FileStore keeps snapshots in a directory, one JSON Lines file per board.
+type FileStore struct {
+	Dir string
+}
+
+func NewFileStore(dir string) (*FileStore, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create snapshot directory: %w", err)
+	}
+	return &FileStore{Dir: dir}, nil
+}
+
+func (fs *FileStore) path(boardID string) string {
+	return filepath.Join(fs.Dir, filepath.Base(boardID)+".jsonl")
+}
+
+func (fs *FileStore) Save(snapshot *Snapshot) error {
+	data, err := json.Marshal(snapshot)
+	if err != nil {
+		return err
+	}
+
+	f, err := os.OpenFile(fs.path(snapshot.BoardID), os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+	if err != nil {
+		return fmt.Errorf("failed to open snapshot file: %w", err)
+	}
+	if _, err := f.Write(append(data, '\n')); err != nil {
+		f.Close()
+		return fmt.Errorf("failed to write snapshot: %w", err)
+	}
+	return f.Close()
+}
+
+func (fs *FileStore) Load(boardID string, from, to time.Time) ([]Snapshot, error) {
+	f, err := os.Open(fs.path(boardID))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to open snapshot file: %w", err)
+	}
+	defer f.Close()
+
+	var snapshots []Snapshot
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+	for line := 1; scanner.Scan(); line++ {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+		var snapshot Snapshot
+		if err := json.Unmarshal(scanner.Bytes(), &snapshot); err != nil {
+			return nil, fmt.Errorf("failed to decode snapshot on line %d: %w", line, err)
+		}
+		if (!from.IsZero() && snapshot.At.Before(from)) || (!to.IsZero() && !snapshot.At.Before(to)) {
+			continue
+		}
+		snapshots = append(snapshots, snapshot)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read snapshots: %w", err)
+	}
+
+	sort.SliceStable(snapshots, func(i, j int) bool { return snapshots[i].At.Before(snapshots[j].At) })
+	return snapshots, nil
+}
@@ -0,0 +1,109 @@
+package analytics
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	fizzy "github.com/rogeriopvl/fizzy-go"
+)
+
+func TestTakeSnapshot(t *testing.T) {
+	t.Run("counts cards per state", func(t *testing.T) {
+		mux := http.NewServeMux()
+		mux.HandleFunc("GET /test-account/boards/board-1/columns", func(w http.ResponseWriter, r *http.Request) {
+			json.NewEncoder(w).Encode([]fizzy.Column{{ID: "col-1", Name: "Doing"}, {ID: "col-2", Name: "Review"}})
+		})
+		mux.HandleFunc("GET /test-account/cards", func(w http.ResponseWriter, r *http.Request) {
+			switch r.URL.Query().Get("indexed_by") {
+			case "all":
+				json.NewEncoder(w).Encode([]fizzy.Card{
+					{ID: "c1"},
+					{ID: "c2", Column: &fizzy.Column{ID: "col-1"}},
+					{ID: "c3", Column: &fizzy.Column{ID: "col-1"}},
+					{ID: "c4", Column: &fizzy.Column{ID: "col-2"}},
+					{ID: "c5"},
+					{ID: "c6"},
+				})
+			case "not_now":
+				json.NewEncoder(w).Encode([]fizzy.Card{{ID: "c6"}})
+			case "closed":
+				json.NewEncoder(w).Encode([]fizzy.Card{{ID: "c5"}, {ID: "c7"}})
+			}
+		})
+		server := httptest.NewServer(mux)
+		defer server.Close()
+
+		client, _ := fizzy.NewClient("/test-account", "test-token", fizzy.WithBaseURL(server.URL))
+		snapshot, err := TakeSnapshot(context.Background(), client, "board-1")
+
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if snapshot.Triage != 1 || snapshot.NotNow != 1 || snapshot.Closed != 2 {
+			t.Errorf("unexpected counts: %+v", snapshot)
+		}
+		if len(snapshot.Columns) != 2 || snapshot.Columns[0].Cards != 2 || snapshot.Columns[1].Cards != 1 {
+			t.Errorf("unexpected columns: %+v", snapshot.Columns)
+		}
+		if snapshot.Total() != 7 {
+			t.Errorf("expected total 7, got %d", snapshot.Total())
+		}
+	})
+
+	t.Run("returns error on failure", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusInternalServerError)
+		}))
+		defer server.Close()
+
+		client, _ := fizzy.NewClient("/test-account", "test-token", fizzy.WithBaseURL(server.URL))
+		if _, err := TakeSnapshot(context.Background(), client, "board-1"); err == nil {
+			t.Error("expected error, got nil")
+		}
+	})
+}
+
+func TestFileStore(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "snapshots")
+	store, err := NewFileStore(dir)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	day := func(d int) time.Time { return time.Date(2025, 12, d, 0, 0, 0, 0, time.UTC) }
+	for _, d := range []int{3, 1, 2} {
+		if err := store.Save(&Snapshot{BoardID: "board-1", At: day(d), Closed: d}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	store.Save(&Snapshot{BoardID: "board-2", At: day(1)})
+
+	all, err := store.Load("board-1", time.Time{}, time.Time{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(all) != 3 || !all[0].At.Equal(day(1)) || !all[2].At.Equal(day(3)) {
+		t.Errorf("expected 3 snapshots oldest first, got %+v", all)
+	}
+
+	ranged, _ := store.Load("board-1", day(2), day(3))
+	if len(ranged) != 1 || ranged[0].Closed != 2 {
+		t.Errorf("expected only the snapshot of day 2, got %+v", ranged)
+	}
+
+	missing, err := store.Load("board-3", time.Time{}, time.Time{})
+	if err != nil || missing != nil {
+		t.Errorf("expected no snapshots for unknown board, got %+v, %v", missing, err)
+	}
+
+	os.WriteFile(filepath.Join(dir, "board-4.jsonl"), []byte("not json\n"), 0o644)
+	if _, err := store.Load("board-4", time.Time{}, time.Time{}); err == nil {
+		t.Error("expected error for corrupt file, got nil")
+	}
+}
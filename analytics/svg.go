@@ -0,0 +1,131 @@
+package analytics
+
+import (
+	"bufio"
+	"fmt"
+	"html"
+	"io"
+	"strings"
+	"time"
+)
+
+const (
+	svgWidth   = 800
+	svgHeight  = 400
+	svgPadding = 50
+	svgLegend  = 150
+)
+
+// svgPalette follows the Fizzy column colors.
+var svgPalette = []string{"#5d9cec", "#a0a0a0", "#c8b08a", "#f6c342", "#8cc152", "#48cfad", "#967adc", "#ac92ec", "#ec87c0"}
+
+// WriteStackedSVG renders the series as a stacked area chart, the usual form
+// of a cumulative flow diagram, with the first line at the bottom.
+func (s *Series) WriteStackedSVG(w io.Writer, title string) error {
+	totals := make([]int, len(s.Times))
+	for _, line := range s.Lines {
+		for t, v := range line.Values {
+			totals[t] += v
+		}
+	}
+
+	return s.writeSVG(w, title, maxInt(totals), func(b *bufio.Writer, chart svgChart) {
+		below := make([]int, len(s.Times))
+		for i, line := range s.Lines {
+			var top, bottom []string
+			for t := range s.Times {
+				top = append(top, chart.point(t, below[t]+line.Values[t]))
+				bottom = append([]string{chart.point(t, below[t])}, bottom...)
+			}
+			fmt.Fprintf(b, `<polygon points="%s" fill="%s" fill-opacity="0.85"/>`+"\n",
+				strings.Join(append(top, bottom...), " "), svgPalette[i%len(svgPalette)])
+			for t := range below {
+				below[t] += line.Values[t]
+			}
+		}
+	})
+}
+
+// WriteLineSVG renders the series as a line chart, the usual form of a
+// burn-up chart.
+func (s *Series) WriteLineSVG(w io.Writer, title string) error {
+	peak := 0
+	for _, line := range s.Lines {
+		peak = max(peak, maxInt(line.Values))
+	}
+
+	return s.writeSVG(w, title, peak, func(b *bufio.Writer, chart svgChart) {
+		for i, line := range s.Lines {
+			points := make([]string, len(line.Values))
+			for t, v := range line.Values {
+				points[t] = chart.point(t, v)
+			}
+			fmt.Fprintf(b, `<polyline points="%s" fill="none" stroke="%s" stroke-width="2"/>`+"\n",
+				strings.Join(points, " "), svgPalette[i%len(svgPalette)])
+		}
+	})
+}
+
+// svgChart maps sample indexes and values to plot coordinates.
+type svgChart struct {
+	samples int
+	peak    int
+}
+
+func (c svgChart) point(t, v int) string {
+	plotWidth := float64(svgWidth - 2*svgPadding - svgLegend)
+	plotHeight := float64(svgHeight - 2*svgPadding)
+
+	x := float64(svgPadding)
+	if c.samples > 1 {
+		x += plotWidth * float64(t) / float64(c.samples-1)
+	}
+	y := float64(svgHeight - svgPadding)
+	if c.peak > 0 {
+		y -= plotHeight * float64(v) / float64(c.peak)
+	}
+	return fmt.Sprintf("%.1f,%.1f", x, y)
+}
+
+func (s *Series) writeSVG(w io.Writer, title string, peak int, plot func(*bufio.Writer, svgChart)) error {
+	b := bufio.NewWriter(w)
+	chart := svgChart{samples: len(s.Times), peak: peak}
+	right := svgWidth - svgPadding - svgLegend
+	bottom := svgHeight - svgPadding
+
+	fmt.Fprintf(b, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d" font-family="sans-serif" font-size="12">`+"\n",
+		svgWidth, svgHeight, svgWidth, svgHeight)
+	fmt.Fprintf(b, `<rect width="100%%" height="100%%" fill="white"/>`+"\n")
+	fmt.Fprintf(b, `<text x="%d" y="%d" font-size="16">%s</text>`+"\n", svgPadding, svgPadding/2+6, html.EscapeString(title))
+
+	if len(s.Times) > 0 {
+		plot(b, chart)
+	}
+
+	fmt.Fprintf(b, `<line x1="%d" y1="%d" x2="%d" y2="%d" stroke="black"/>`+"\n", svgPadding, bottom, right, bottom)
+	fmt.Fprintf(b, `<line x1="%d" y1="%d" x2="%d" y2="%d" stroke="black"/>`+"\n", svgPadding, svgPadding, svgPadding, bottom)
+	fmt.Fprintf(b, `<text x="%d" y="%d" text-anchor="end">%d</text>`+"\n", svgPadding-6, svgPadding+4, peak)
+	fmt.Fprintf(b, `<text x="%d" y="%d" text-anchor="end">0</text>`+"\n", svgPadding-6, bottom+4)
+	if len(s.Times) > 0 {
+		fmt.Fprintf(b, `<text x="%d" y="%d">%s</text>`+"\n", svgPadding, bottom+20, s.Times[0].Format(time.DateOnly))
+		fmt.Fprintf(b, `<text x="%d" y="%d" text-anchor="end">%s</text>`+"\n", right, bottom+20, s.Times[len(s.Times)-1].Format(time.DateOnly))
+	}
+
+	// The legend lists lines top to bottom, matching a stacked chart.
+	for i := len(s.Lines) - 1; i >= 0; i-- {
+		y := svgPadding + 20*(len(s.Lines)-1-i)
+		fmt.Fprintf(b, `<rect x="%d" y="%d" width="12" height="12" fill="%s"/>`+"\n", right+20, y, svgPalette[i%len(svgPalette)])
+		fmt.Fprintf(b, `<text x="%d" y="%d">%s</text>`+"\n", right+38, y+10, html.EscapeString(s.Lines[i].Name))
+	}
+
+	b.WriteString("</svg>\n")
+	return b.Flush()
+}
+
+func maxInt(values []int) int {
+	peak := 0
+	for _, v := range values {
+		peak = max(peak, v)
+	}
+	return peak
+}
@@ -0,0 +1,67 @@
+package analytics
+
+import (
+	"bytes"
+	"encoding/xml"
+	"io"
+	"strings"
+	"testing"
+)
+
+// checkXML fails the test if svg is not well-formed.
+func checkXML(t *testing.T, svg string) {
+	t.Helper()
+	dec := xml.NewDecoder(strings.NewReader(svg))
+	for {
+		_, err := dec.Token()
+		if err == io.EOF {
+			return
+		}
+		if err != nil {
+			t.Fatalf("invalid SVG: %v\n%s", err, svg)
+		}
+	}
+}
+
+func TestWriteStackedSVG(t *testing.T) {
+	snapshots := append([]Snapshot(nil), testSnapshots...)
+	snapshots[1].Columns = append([]ColumnSnapshot{{Name: "Q&A <urgent>", Cards: 1}}, snapshots[1].Columns...)
+
+	var buf bytes.Buffer
+	if err := CumulativeFlow(snapshots).WriteStackedSVG(&buf, "Board & flow"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	svg := buf.String()
+	checkXML(t, svg)
+	if got := strings.Count(svg, "<polygon"); got != 6 {
+		t.Errorf("expected 6 bands, got %d", got)
+	}
+	for _, want := range []string{"Board &amp; flow", "Q&amp;A &lt;urgent&gt;", ">Not Now<", ">2025-12-01<", ">2025-12-02<"} {
+		if !strings.Contains(svg, want) {
+			t.Errorf("expected SVG to contain %q", want)
+		}
+	}
+}
+
+func TestWriteLineSVG(t *testing.T) {
+	var buf bytes.Buffer
+	if err := BurnUp(testSnapshots).WriteLineSVG(&buf, "Burn-up"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	svg := buf.String()
+	checkXML(t, svg)
+	if got := strings.Count(svg, "<polyline"); got != 2 {
+		t.Errorf("expected 2 lines, got %d", got)
+	}
+	if !strings.Contains(svg, ">Scope<") || !strings.Contains(svg, ">8<") {
+		t.Errorf("expected legend and peak label, got:\n%s", svg)
+	}
+
+	buf.Reset()
+	if err := BurnUp(nil).WriteLineSVG(&buf, "Empty"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	checkXML(t, buf.String())
+}
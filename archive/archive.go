@@ -0,0 +1,196 @@
+// Package archive exports a Fizzy account to a portable directory of JSON
+// files and reads those archives back.
+//
+// An archive directory has the following layout:
+//
+//	manifest.json      format version and export metadata
+//	users.json         []fizzy.User
+//	tags.json          []fizzy.Tag
+//	boards.json        []BoardRecord (boards with their columns)
+//	cards/<n>.json     one CardRecord per card number
+//	images/<n>.<ext>   downloaded card header images
+//
+// Records keep the IDs assigned by the source account, so cards reference
+// boards, columns and users by ID and an importer can map them to new ones.
+package archive
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	fizzy "github.com/rogeriopvl/fizzy-go"
+	"github.com/rogeriopvl/fizzy-go/internal/shared"
+)
+
+// FormatVersion is the archive layout version written by Export.
+const FormatVersion = 1
+
+const (
+	manifestFile = "manifest.json"
+	usersFile    = "users.json"
+	tagsFile     = "tags.json"
+	boardsFile   = "boards.json"
+	cardsDir     = "cards"
+	imagesDir    = "images"
+)
+
+// ErrUnsupportedVersion is returned when an archive was written by a newer
+// format version than this package understands.
+var ErrUnsupportedVersion = errors.New("unsupported archive format version")
+
+// Manifest describes an archive.
+type Manifest struct {
+	FormatVersion  int    `json:"format_version"`
+	AccountBaseURL string `json:"account_base_url"`
+	ExportedAt     string `json:"exported_at"`
+	Complete       bool   `json:"complete"`
+	CardCount      int    `json:"card_count"`
+}
+
+// BoardRecord is a board together with its columns, in board order.
+type BoardRecord struct {
+	Board   fizzy.Board    `json:"board"`
+	Columns []fizzy.Column `json:"columns"`
+}
+
+// CardRecord is a card with everything hanging off it. Steps are carried on
+// Card.Steps. Image is the image path relative to the archive root, if one
+// was downloaded.
+type CardRecord struct {
+	Card     fizzy.Card      `json:"card"`
+	NotNow   bool            `json:"not_now"`
+	Comments []CommentRecord `json:"comments"`
+	Image    string          `json:"image,omitempty"`
+}
+
+// CommentRecord is a comment with its reactions.
+type CommentRecord struct {
+	Comment   fizzy.Comment    `json:"comment"`
+	Reactions []fizzy.Reaction `json:"reactions"`
+}
+
+// Archive is an archive directory opened for reading.
+type Archive struct {
+	Dir      string
+	Manifest Manifest
+	Users    []fizzy.User
+	Tags     []fizzy.Tag
+	Boards   []BoardRecord
+}
+
+// Open reads the account-level files of the archive in dir. Cards are read
+// lazily with Cards.
+func Open(dir string) (*Archive, error) {
+	a := &Archive{Dir: dir}
+
+	if err := readJSON(filepath.Join(dir, manifestFile), &a.Manifest); err != nil {
+		return nil, err
+	}
+	if a.Manifest.FormatVersion > FormatVersion {
+		return nil, fmt.Errorf("%w: %d", ErrUnsupportedVersion, a.Manifest.FormatVersion)
+	}
+	if err := readJSON(filepath.Join(dir, usersFile), &a.Users); err != nil {
+		return nil, err
+	}
+	if err := readJSON(filepath.Join(dir, tagsFile), &a.Tags); err != nil {
+		return nil, err
+	}
+	if err := readJSON(filepath.Join(dir, boardsFile), &a.Boards); err != nil {
+		return nil, err
+	}
+
+	return a, nil
+}
+
+// CardNumbers returns the numbers of all cards in the archive, ascending.
+func (a *Archive) CardNumbers() ([]int, error) {
+	entries, err := os.ReadDir(filepath.Join(a.Dir, cardsDir))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to list archived cards: %w", err)
+	}
+
+	var numbers []int
+	for _, entry := range entries {
+		name, ok := strings.CutSuffix(entry.Name(), ".json")
+		if !ok {
+			continue
+		}
+		n, err := strconv.Atoi(name)
+		if err != nil {
+			continue
+		}
+		numbers = append(numbers, n)
+	}
+	sort.Ints(numbers)
+
+	return numbers, nil
+}
+
+// Card reads the record for card number n.
+func (a *Archive) Card(n int) (*CardRecord, error) {
+	var record CardRecord
+	if err := readJSON(cardPath(a.Dir, n), &record); err != nil {
+		return nil, err
+	}
+	return &record, nil
+}
+
+// Cards reads every card record in the archive, ordered by card number.
+func (a *Archive) Cards() ([]CardRecord, error) {
+	numbers, err := a.CardNumbers()
+	if err != nil {
+		return nil, err
+	}
+
+	records := make([]CardRecord, 0, len(numbers))
+	for _, n := range numbers {
+		record, err := a.Card(n)
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, *record)
+	}
+
+	return records, nil
+}
+
+func cardPath(dir string, n int) string {
+	return filepath.Join(dir, cardsDir, strconv.Itoa(n)+".json")
+}
+
+func readJSON(path string, v any) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %w", path, err)
+	}
+	if err := json.Unmarshal(data, v); err != nil {
+		return fmt.Errorf("failed to decode %s: %w", path, err)
+	}
+	return nil
+}
+
+// writeJSON writes v to path atomically, so an interrupted export never
+// leaves a truncated file behind for a resumed run to trust.
+func writeJSON(path string, v any) error {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode %s: %w", path, err)
+	}
+	return writeFileAtomic(path, data)
+}
+
+func writeFileAtomic(path string, data []byte) error {
+	if err := shared.WriteFileAtomic(path, data); err != nil {
+		return fmt.Errorf("failed to write %s: %w", path, err)
+	}
+	return nil
+}
@@ -0,0 +1,38 @@
+package archive
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+)
+
+func TestOpen(t *testing.T) {
+	t.Run("rejects newer format versions", func(t *testing.T) {
+		dir := t.TempDir()
+		writeJSON(filepath.Join(dir, manifestFile), Manifest{FormatVersion: FormatVersion + 1})
+
+		_, err := Open(dir)
+
+		if !errors.Is(err, ErrUnsupportedVersion) {
+			t.Errorf("expected ErrUnsupportedVersion, got %v", err)
+		}
+	})
+
+	t.Run("returns error when manifest is missing", func(t *testing.T) {
+		_, err := Open(t.TempDir())
+
+		if err == nil {
+			t.Fatal("expected error, got nil")
+		}
+	})
+}
+
+func TestCardNumbers(t *testing.T) {
+	dir := t.TempDir()
+	a := &Archive{Dir: dir}
+
+	numbers, err := a.CardNumbers()
+	if err != nil || len(numbers) != 0 {
+		t.Fatalf("expected no cards in an empty archive, got %v (%v)", numbers, err)
+	}
+}
@@ -0,0 +1,240 @@
+package archive
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	fizzy "github.com/rogeriopvl/fizzy-go"
+)
+
+// cardIndexes are the GetCards indexes walked by Export. The default "all"
+// index leaves out closed and postponed cards, so those are fetched
+// separately and merged.
+var cardIndexes = []string{"all", "closed", "not_now"}
+
+// ExportOptions configures Export.
+type ExportOptions struct {
+	// SkipImages disables downloading card header images.
+	SkipImages bool
+
+	// Progress, if set, is called after each card is written or skipped.
+	Progress func(done, total int)
+}
+
+// Export writes every board, column, card, comment, step, reaction, tag and
+// user visible to client into dir.
+//
+// Export is resumable: cards already present in dir from an earlier,
+// interrupted run are not fetched again. The manifest is marked Complete only
+// once every card has been written.
+func Export(ctx context.Context, client *fizzy.Client, dir string, opts ExportOptions) (*Manifest, error) {
+	for _, sub := range []string{dir, filepath.Join(dir, cardsDir), filepath.Join(dir, imagesDir)} {
+		if err := os.MkdirAll(sub, 0o755); err != nil {
+			return nil, fmt.Errorf("failed to create archive directory: %w", err)
+		}
+	}
+
+	manifest := &Manifest{
+		FormatVersion:  FormatVersion,
+		AccountBaseURL: client.AccountBaseURL,
+		ExportedAt:     time.Now().UTC().Format(time.RFC3339),
+	}
+	if err := writeJSON(filepath.Join(dir, manifestFile), manifest); err != nil {
+		return nil, err
+	}
+
+	users, err := client.GetUsers(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to export users: %w", err)
+	}
+	if err := writeJSON(filepath.Join(dir, usersFile), users); err != nil {
+		return nil, err
+	}
+
+	tags, err := client.GetTags(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to export tags: %w", err)
+	}
+	if err := writeJSON(filepath.Join(dir, tagsFile), tags); err != nil {
+		return nil, err
+	}
+
+	boards, err := client.GetBoards(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to export boards: %w", err)
+	}
+	records := make([]BoardRecord, 0, len(boards))
+	for _, board := range boards {
+		columns, err := client.ForBoard(board.ID).GetColumns(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to export columns of board %s: %w", board.ID, err)
+		}
+		records = append(records, BoardRecord{Board: board, Columns: columns})
+	}
+	if err := writeJSON(filepath.Join(dir, boardsFile), records); err != nil {
+		return nil, err
+	}
+
+	cards, notNow, err := listAllCards(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, card := range cards {
+		if _, err := os.Stat(cardPath(dir, card.Number)); err == nil {
+			reportProgress(opts, i+1, len(cards))
+			continue
+		}
+
+		record, err := exportCard(ctx, client, dir, card.Number, opts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to export card %d: %w", card.Number, err)
+		}
+		record.NotNow = notNow[card.ID]
+
+		if err := writeJSON(cardPath(dir, card.Number), record); err != nil {
+			return nil, err
+		}
+		reportProgress(opts, i+1, len(cards))
+	}
+
+	manifest.Complete = true
+	manifest.CardCount = len(cards)
+	if err := writeJSON(filepath.Join(dir, manifestFile), manifest); err != nil {
+		return nil, err
+	}
+
+	return manifest, nil
+}
+
+// listAllCards merges the card indexes into one list, remembering which
+// cards came from the "Not Now" index since the card payload does not say.
+func listAllCards(ctx context.Context, client *fizzy.Client) ([]fizzy.Card, map[string]bool, error) {
+	seen := make(map[string]bool)
+	notNow := make(map[string]bool)
+	var cards []fizzy.Card
+
+	for _, index := range cardIndexes {
+		page, err := client.GetCards(ctx, fizzy.CardFilters{IndexedBy: index})
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to list %s cards: %w", index, err)
+		}
+		for _, card := range page {
+			if index == "not_now" {
+				notNow[card.ID] = true
+			}
+			if seen[card.ID] {
+				continue
+			}
+			seen[card.ID] = true
+			cards = append(cards, card)
+		}
+	}
+
+	return cards, notNow, nil
+}
+
+func exportCard(ctx context.Context, client *fizzy.Client, dir string, number int, opts ExportOptions) (*CardRecord, error) {
+	// The single-card endpoint includes steps, which the list endpoint omits.
+	card, err := client.GetCard(ctx, number)
+	if err != nil {
+		return nil, err
+	}
+
+	comments, err := client.GetCardComments(ctx, number)
+	if err != nil {
+		return nil, err
+	}
+
+	record := &CardRecord{Card: *card, Comments: make([]CommentRecord, 0, len(comments))}
+	for _, comment := range comments {
+		reactions, err := client.GetCommentReactions(ctx, number, comment.ID)
+		if err != nil {
+			return nil, err
+		}
+		record.Comments = append(record.Comments, CommentRecord{Comment: comment, Reactions: reactions})
+	}
+
+	if card.ImageURL != "" && !opts.SkipImages {
+		image, err := downloadImage(ctx, client, dir, number, card.ImageURL)
+		if err != nil {
+			return nil, err
+		}
+		record.Image = image
+	}
+
+	return record, nil
+}
+
+// downloadImage stores the card image under images/ and returns its path
+// relative to the archive root. The access token is only sent when the image
+// is served from the Fizzy host itself, never to third-party storage.
+func downloadImage(ctx context.Context, client *fizzy.Client, dir string, number int, imageURL string) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, imageURL, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to create image request: %w", err)
+	}
+	if sameHost(imageURL, client.BaseURL) {
+		req.Header.Set("Authorization", "Bearer "+client.AccessToken)
+	}
+
+	res, err := client.HTTPClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to download image: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("failed to download image: unexpected status code %d", res.StatusCode)
+	}
+
+	data, err := io.ReadAll(res.Body)
+	if err != nil {
+		return "", fmt.Errorf("failed to download image: %w", err)
+	}
+
+	rel := path.Join(imagesDir, strconv.Itoa(number)+imageExtension(imageURL, res.Header.Get("Content-Type")))
+	if err := writeFileAtomic(filepath.Join(dir, filepath.FromSlash(rel)), data); err != nil {
+		return "", err
+	}
+
+	return rel, nil
+}
+
+func imageExtension(imageURL, contentType string) string {
+	if u, err := url.Parse(imageURL); err == nil {
+		if ext := path.Ext(u.Path); ext != "" {
+			return ext
+		}
+	}
+	if mediaType, _, err := mime.ParseMediaType(contentType); err == nil {
+		if exts, _ := mime.ExtensionsByType(mediaType); len(exts) > 0 {
+			return exts[0]
+		}
+	}
+	return ".bin"
+}
+
+func sameHost(a, b string) bool {
+	ua, errA := url.Parse(a)
+	ub, errB := url.Parse(b)
+	if errA != nil || errB != nil {
+		return false
+	}
+	return ua.Host == ub.Host
+}
+
+func reportProgress(opts ExportOptions, done, total int) {
+	if opts.Progress != nil {
+		opts.Progress(done, total)
+	}
+}
@@ -0,0 +1,141 @@
+package archive
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+
+	fizzy "github.com/rogeriopvl/fizzy-go"
+)
+
+func newExportServer(t *testing.T, cardFetches *int32) *httptest.Server {
+	t.Helper()
+
+	var server *httptest.Server
+	mux := http.NewServeMux()
+	encode := func(w http.ResponseWriter, v any) {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(v)
+	}
+
+	mux.HandleFunc("GET /test-account/users", func(w http.ResponseWriter, r *http.Request) {
+		encode(w, []fizzy.User{{ID: "user-1", Email: "ana@example.com"}})
+	})
+	mux.HandleFunc("GET /test-account/tags", func(w http.ResponseWriter, r *http.Request) {
+		encode(w, []fizzy.Tag{{ID: "tag-1", Title: "bug"}})
+	})
+	mux.HandleFunc("GET /test-account/boards", func(w http.ResponseWriter, r *http.Request) {
+		encode(w, []fizzy.Board{{ID: "board-1", Name: "Product"}})
+	})
+	mux.HandleFunc("GET /test-account/boards/board-1/columns", func(w http.ResponseWriter, r *http.Request) {
+		encode(w, []fizzy.Column{{ID: "col-1", Name: "Doing"}})
+	})
+	mux.HandleFunc("GET /test-account/cards", func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Query().Get("indexed_by") {
+		case "all":
+			encode(w, []fizzy.Card{{ID: "card-1", Number: 1}})
+		case "closed":
+			encode(w, []fizzy.Card{{ID: "card-2", Number: 2, Closed: true}})
+		case "not_now":
+			encode(w, []fizzy.Card{{ID: "card-3", Number: 3}})
+		}
+	})
+	mux.HandleFunc("GET /test-account/cards/{number}", func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(cardFetches, 1)
+		card := fizzy.Card{Number: 1, ID: "card-" + r.PathValue("number"), Steps: []fizzy.Step{{ID: "step-1", Content: "Check"}}}
+		if r.PathValue("number") == "1" {
+			card.ImageURL = server.URL + "/images/header.png"
+		}
+		encode(w, card)
+	})
+	mux.HandleFunc("GET /test-account/cards/{number}/comments", func(w http.ResponseWriter, r *http.Request) {
+		encode(w, []fizzy.Comment{{ID: "comment-1"}})
+	})
+	mux.HandleFunc("GET /test-account/cards/{number}/comments/comment-1/reactions", func(w http.ResponseWriter, r *http.Request) {
+		encode(w, []fizzy.Reaction{{ID: "reaction-1", Content: "👍"}})
+	})
+	mux.HandleFunc("GET /images/header.png", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer test-token" {
+			t.Errorf("expected image request to be authenticated")
+		}
+		w.Header().Set("Content-Type", "image/png")
+		w.Write([]byte("png-bytes"))
+	})
+
+	server = httptest.NewServer(mux)
+	return server
+}
+
+func TestExport(t *testing.T) {
+	t.Run("writes a complete archive", func(t *testing.T) {
+		var fetches int32
+		server := newExportServer(t, &fetches)
+		defer server.Close()
+
+		dir := t.TempDir()
+		client, _ := fizzy.NewClient("/test-account", "test-token", fizzy.WithBaseURL(server.URL))
+		manifest, err := Export(context.Background(), client, dir, ExportOptions{})
+
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !manifest.Complete || manifest.CardCount != 3 {
+			t.Errorf("expected complete manifest with 3 cards, got %+v", manifest)
+		}
+
+		a, err := Open(dir)
+		if err != nil {
+			t.Fatalf("unexpected error opening archive: %v", err)
+		}
+		if len(a.Boards) != 1 || len(a.Boards[0].Columns) != 1 {
+			t.Errorf("expected 1 board with 1 column, got %+v", a.Boards)
+		}
+
+		cards, err := a.Cards()
+		if err != nil {
+			t.Fatalf("unexpected error reading cards: %v", err)
+		}
+		if len(cards) != 3 {
+			t.Fatalf("expected 3 cards, got %d", len(cards))
+		}
+		if len(cards[0].Card.Steps) != 1 || len(cards[0].Comments[0].Reactions) != 1 {
+			t.Errorf("expected steps and reactions on card 1, got %+v", cards[0])
+		}
+		if !cards[2].NotNow {
+			t.Error("expected card 3 to be marked not now")
+		}
+		if cards[0].Image != "images/1.png" {
+			t.Errorf("expected image 'images/1.png', got '%s'", cards[0].Image)
+		}
+		if data, _ := os.ReadFile(filepath.Join(dir, "images", "1.png")); string(data) != "png-bytes" {
+			t.Errorf("unexpected image contents: %q", data)
+		}
+	})
+
+	t.Run("skips cards already exported", func(t *testing.T) {
+		var fetches int32
+		server := newExportServer(t, &fetches)
+		defer server.Close()
+
+		dir := t.TempDir()
+		client, _ := fizzy.NewClient("/test-account", "test-token", fizzy.WithBaseURL(server.URL))
+		if _, err := Export(context.Background(), client, dir, ExportOptions{SkipImages: true}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		os.Remove(cardPath(dir, 2))
+		atomic.StoreInt32(&fetches, 0)
+
+		if _, err := Export(context.Background(), client, dir, ExportOptions{SkipImages: true}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if fetches != 1 {
+			t.Errorf("expected only the missing card to be fetched, got %d fetches", fetches)
+		}
+	})
+}
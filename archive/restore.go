@@ -0,0 +1,382 @@
+package archive
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"html"
+	"os"
+	"path/filepath"
+
+	fizzy "github.com/rogeriopvl/fizzy-go"
+)
+
+const journalFile = "restore-journal.json"
+
+// ErrConflict is returned by Restore when an archived board's name is already
+// taken in the target account and the conflict policy is ConflictFail.
+var ErrConflict = errors.New("board name already exists in target account")
+
+// ConflictPolicy decides what Restore does when a board with the same name
+// already exists in the target account.
+type ConflictPolicy int
+
+const (
+	// ConflictFail aborts the restore before anything is created.
+	ConflictFail ConflictPolicy = iota
+	// ConflictSkip leaves the existing board alone and skips the archived
+	// board along with its columns and cards.
+	ConflictSkip
+	// ConflictRename restores the board under "<name> (restored)".
+	ConflictRename
+)
+
+// RestoreOptions configures Restore.
+type RestoreOptions struct {
+	// DryRun reports what would be created without calling any mutating
+	// endpoint or writing the journal.
+	DryRun bool
+
+	// OnConflict selects the behaviour on board name clashes.
+	OnConflict ConflictPolicy
+
+	// JournalPath is where progress is recorded so an interrupted restore
+	// can be resumed. Defaults to restore-journal.json inside the archive.
+	JournalPath string
+
+	// NoteCommentAuthors prefixes each restored comment with its original
+	// author, since comments are re-created as the token's user.
+	NoteCommentAuthors bool
+}
+
+// Journal records the mapping from archived IDs to the IDs created in the
+// target account, and how far each card has been restored.
+type Journal struct {
+	Boards        map[string]string        `json:"boards"`
+	Columns       map[string]string        `json:"columns"`
+	Cards         map[string]*CardProgress `json:"cards"`
+	SkippedBoards map[string]bool          `json:"skipped_boards"`
+}
+
+// CardProgress tracks a single card's restore so a resumed run neither
+// duplicates nor drops tags, steps or comments.
+type CardProgress struct {
+	Number   int  `json:"number"`
+	Tags     int  `json:"tags"`
+	Steps    int  `json:"steps"`
+	Comments int  `json:"comments"`
+	Done     bool `json:"done"`
+}
+
+// RestoreReport describes a restore run.
+type RestoreReport struct {
+	// Actions lists every operation performed, or that would be performed
+	// in a dry run, in order.
+	Actions []string
+
+	// Conflicts lists the archived board names that clashed with existing
+	// boards in the target account.
+	Conflicts []string
+
+	Journal *Journal
+}
+
+// Restore recreates the boards, columns, cards, tags, steps and comments of
+// the archive a in the account of client, then reapplies each card's column,
+// closed, golden and not-now state and original activity timestamps.
+//
+// Users are not created: the archive is restored as the token's user.
+// Reactions and card images are not restored.
+func Restore(ctx context.Context, client *fizzy.Client, a *Archive, opts RestoreOptions) (*RestoreReport, error) {
+	if opts.JournalPath == "" {
+		opts.JournalPath = filepath.Join(a.Dir, journalFile)
+	}
+
+	journal, err := loadJournal(opts.JournalPath)
+	if err != nil {
+		return nil, err
+	}
+
+	r := &restorer{
+		client:  client,
+		opts:    opts,
+		journal: journal,
+		report:  &RestoreReport{Journal: journal},
+	}
+
+	if err := r.restoreBoards(ctx, a.Boards); err != nil {
+		return r.report, err
+	}
+
+	records, err := a.Cards()
+	if err != nil {
+		return r.report, err
+	}
+	for _, record := range records {
+		if err := r.restoreCard(ctx, record); err != nil {
+			return r.report, fmt.Errorf("failed to restore card %d: %w", record.Card.Number, err)
+		}
+	}
+
+	return r.report, nil
+}
+
+type restorer struct {
+	client  *fizzy.Client
+	opts    RestoreOptions
+	journal *Journal
+	report  *RestoreReport
+}
+
+// do records action and, unless this is a dry run, performs it and persists
+// the journal so a crash right after it does not repeat it.
+func (r *restorer) do(action string, fn func() error) error {
+	r.report.Actions = append(r.report.Actions, action)
+	if r.opts.DryRun {
+		return nil
+	}
+	if err := fn(); err != nil {
+		return fmt.Errorf("%s: %w", action, err)
+	}
+	return writeJSON(r.opts.JournalPath, r.journal)
+}
+
+func (r *restorer) restoreBoards(ctx context.Context, records []BoardRecord) error {
+	existing, err := r.client.GetBoards(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list target boards: %w", err)
+	}
+	taken := make(map[string]bool, len(existing))
+	for _, board := range existing {
+		taken[board.Name] = true
+	}
+
+	// Detect every conflict up front so ConflictFail aborts before any
+	// board has been created.
+	names := make(map[string]string, len(records))
+	for _, record := range records {
+		board := record.Board
+		if _, done := r.journal.Boards[board.ID]; done || r.journal.SkippedBoards[board.ID] {
+			continue
+		}
+
+		name := board.Name
+		if taken[name] {
+			r.report.Conflicts = append(r.report.Conflicts, name)
+			switch r.opts.OnConflict {
+			case ConflictSkip:
+				r.journal.SkippedBoards[board.ID] = true
+				continue
+			case ConflictRename:
+				name += " (restored)"
+			}
+		}
+		names[board.ID] = name
+	}
+	if len(r.report.Conflicts) > 0 && r.opts.OnConflict == ConflictFail {
+		return fmt.Errorf("%w: %v", ErrConflict, r.report.Conflicts)
+	}
+
+	for _, record := range records {
+		board := record.Board
+		if r.journal.SkippedBoards[board.ID] {
+			continue
+		}
+
+		if _, done := r.journal.Boards[board.ID]; !done {
+			payload := fizzy.CreateBoardPayload{Name: names[board.ID], AllAccess: board.AllAccess}
+			err := r.do(fmt.Sprintf("create board %q", payload.Name), func() error {
+				id, err := r.client.CreateBoardAndGetID(ctx, payload)
+				if err != nil {
+					return err
+				}
+				r.journal.Boards[board.ID] = id
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		boardClient := r.client.ForBoard(r.journal.Boards[board.ID])
+		for _, column := range record.Columns {
+			if _, done := r.journal.Columns[column.ID]; done {
+				continue
+			}
+
+			payload := fizzy.CreateColumnPayload{Name: column.Name}
+			if column.Color.Value != "" {
+				color := column.Color.Value
+				payload.Color = &color
+			}
+			err := r.do(fmt.Sprintf("create column %q on board %q", column.Name, board.Name), func() error {
+				id, err := boardClient.CreateColumnAndGetID(ctx, payload)
+				if err != nil {
+					return err
+				}
+				r.journal.Columns[column.ID] = id
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (r *restorer) restoreCard(ctx context.Context, record CardRecord) error {
+	card := record.Card
+	if r.journal.SkippedBoards[card.Board.ID] {
+		return nil
+	}
+
+	progress := r.journal.Cards[card.ID]
+	if progress == nil {
+		progress = &CardProgress{}
+	}
+	if progress.Done {
+		return nil
+	}
+
+	label := fmt.Sprintf("card #%d %q", card.Number, card.Title)
+
+	if progress.Number == 0 {
+		description := card.DescriptionHTML
+		if description == "" {
+			description = card.Description
+		}
+		payload := fizzy.CreateCardPayload{
+			Title:        card.Title,
+			Description:  description,
+			Status:       card.Status,
+			CreatedAt:    card.CreatedAt,
+			LastActiveAt: card.LastActiveAt,
+		}
+		boardClient := r.client.ForBoard(r.journal.Boards[card.Board.ID])
+		err := r.do("create "+label, func() error {
+			number, err := boardClient.CreateCardAndGetNumber(ctx, payload)
+			if err != nil {
+				return err
+			}
+			progress.Number = number
+			r.journal.Cards[card.ID] = progress
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	// Tagging toggles, so a tag applied just before an interrupted journal
+	// write must not be toggled again on resume.
+	for i := progress.Tags; i < len(card.Tags); i++ {
+		tag := card.Tags[i]
+		err := r.do(fmt.Sprintf("tag %s with %q", label, tag), func() error {
+			if err := r.client.EnsureTagged(ctx, progress.Number, tag); err != nil {
+				return err
+			}
+			progress.Tags = i + 1
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	for i := progress.Steps; i < len(card.Steps); i++ {
+		step := card.Steps[i]
+		err := r.do(fmt.Sprintf("add step %q to %s", step.Content, label), func() error {
+			if _, err := r.client.CreateCardStep(ctx, progress.Number, step.Content, step.Completed); err != nil {
+				return err
+			}
+			progress.Steps = i + 1
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	for i := progress.Comments; i < len(record.Comments); i++ {
+		comment := record.Comments[i].Comment
+		body := comment.Body.HTML
+		if body == "" {
+			body = html.EscapeString(comment.Body.PlainText)
+		}
+		if r.opts.NoteCommentAuthors && comment.Creator.Name != "" {
+			body = fmt.Sprintf("<p><em>Originally posted by %s</em></p>%s", html.EscapeString(comment.Creator.Name), body)
+		}
+		err := r.do(fmt.Sprintf("add comment %s to %s", comment.ID, label), func() error {
+			if _, err := r.client.CreateCardCommentAt(ctx, progress.Number, body, comment.CreatedAt); err != nil {
+				return err
+			}
+			progress.Comments = i + 1
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	// Column, closure and postponement are applied together at the end and
+	// are idempotent on the server, so a resumed run can safely repeat them.
+	var states []string
+	var calls []func() error
+	if card.Column != nil {
+		if columnID, ok := r.journal.Columns[card.Column.ID]; ok || r.opts.DryRun {
+			states = append(states, fmt.Sprintf("move %s to column %q", label, card.Column.Name))
+			calls = append(calls, func() error { return r.client.TriageCard(ctx, progress.Number, columnID) })
+		}
+	}
+	switch {
+	case card.Closed:
+		states = append(states, "close "+label)
+		calls = append(calls, func() error { return r.client.CloseCard(ctx, progress.Number) })
+	case record.NotNow:
+		states = append(states, "postpone "+label)
+		calls = append(calls, func() error { return r.client.PostponeCard(ctx, progress.Number) })
+	}
+	if card.Golden {
+		states = append(states, "mark "+label+" golden")
+		calls = append(calls, func() error { return r.client.MarkCardGolden(ctx, progress.Number) })
+	}
+	if card.LastActiveAt != "" {
+		states = append(states, "restore last activity of "+label)
+		calls = append(calls, func() error {
+			_, err := r.client.UpdateCard(ctx, progress.Number, fizzy.UpdateCardPayload{LastActiveAt: card.LastActiveAt})
+			return err
+		})
+	}
+
+	for i, state := range states {
+		if err := r.do(state, calls[i]); err != nil {
+			return err
+		}
+	}
+
+	if r.opts.DryRun {
+		return nil
+	}
+	progress.Done = true
+	return writeJSON(r.opts.JournalPath, r.journal)
+}
+
+func loadJournal(path string) (*Journal, error) {
+	journal := &Journal{
+		Boards:        make(map[string]string),
+		Columns:       make(map[string]string),
+		Cards:         make(map[string]*CardProgress),
+		SkippedBoards: make(map[string]bool),
+	}
+
+	if _, err := os.Stat(path); errors.Is(err, os.ErrNotExist) {
+		return journal, nil
+	}
+	if err := readJSON(path, journal); err != nil {
+		return nil, err
+	}
+
+	return journal, nil
+}
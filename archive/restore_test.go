@@ -0,0 +1,255 @@
+package archive
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	fizzy "github.com/rogeriopvl/fizzy-go"
+)
+
+type restoreServer struct {
+	*httptest.Server
+	mu    sync.Mutex
+	calls []string
+	// tags are the tags the restored card reports having.
+	tags []string
+}
+
+func (s *restoreServer) record(r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.calls = append(s.calls, r.Method+" "+strings.TrimPrefix(r.URL.Path, "/target"))
+}
+
+func (s *restoreServer) mutations() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var out []string
+	for _, call := range s.calls {
+		if !strings.HasPrefix(call, "GET ") {
+			out = append(out, call)
+		}
+	}
+	return out
+}
+
+func newRestoreServer(t *testing.T, existing []fizzy.Board) *restoreServer {
+	t.Helper()
+
+	s := &restoreServer{}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.record(r)
+		path := strings.TrimPrefix(r.URL.Path, "/target")
+
+		switch {
+		case r.Method == http.MethodGet && path == "/boards":
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(existing)
+		case r.Method == http.MethodPost && path == "/boards":
+			w.Header().Set("Location", "/target/boards/new-board.json")
+			w.WriteHeader(http.StatusCreated)
+		case r.Method == http.MethodPost && path == "/boards/new-board/columns":
+			w.Header().Set("Location", "/target/boards/new-board/columns/new-col.json")
+			w.WriteHeader(http.StatusCreated)
+		case r.Method == http.MethodPost && path == "/boards/new-board/cards":
+			w.Header().Set("Location", "/target/cards/501")
+			w.WriteHeader(http.StatusCreated)
+		case r.Method == http.MethodPost && (strings.HasSuffix(path, "/steps") || strings.HasSuffix(path, "/comments")):
+			w.WriteHeader(http.StatusCreated)
+		case r.Method == http.MethodGet && path == "/cards/501":
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(fizzy.Card{Number: 501, Tags: s.tags})
+		case r.Method == http.MethodPut && path == "/cards/501":
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(fizzy.Card{Number: 501})
+		default:
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+
+	return s
+}
+
+func writeTestArchive(t *testing.T) *Archive {
+	t.Helper()
+
+	dir := t.TempDir()
+	os.MkdirAll(filepath.Join(dir, cardsDir), 0o755)
+
+	writeJSON(filepath.Join(dir, manifestFile), Manifest{FormatVersion: FormatVersion, Complete: true})
+	writeJSON(filepath.Join(dir, usersFile), []fizzy.User{})
+	writeJSON(filepath.Join(dir, tagsFile), []fizzy.Tag{})
+	writeJSON(filepath.Join(dir, boardsFile), []BoardRecord{{
+		Board:   fizzy.Board{ID: "old-board", Name: "Product"},
+		Columns: []fizzy.Column{{ID: "old-col", Name: "Doing", Color: fizzy.ColorObject{Value: fizzy.ColorLime}}},
+	}})
+
+	record := CardRecord{
+		Card: fizzy.Card{
+			ID:           "old-card",
+			Number:       7,
+			Title:        "Ship it",
+			Tags:         []string{"bug"},
+			Closed:       true,
+			Golden:       true,
+			CreatedAt:    "2025-01-01T00:00:00Z",
+			LastActiveAt: "2025-02-01T00:00:00Z",
+			Board:        fizzy.Board{ID: "old-board"},
+			Column:       &fizzy.Column{ID: "old-col", Name: "Doing"},
+			Steps:        []fizzy.Step{{Content: "Test", Completed: true}},
+		},
+		Comments: []CommentRecord{{Comment: fizzy.Comment{ID: "old-comment"}}},
+	}
+	writeJSON(cardPath(dir, 7), record)
+
+	a, err := Open(dir)
+	if err != nil {
+		t.Fatalf("failed to open test archive: %v", err)
+	}
+	return a
+}
+
+func TestRestore(t *testing.T) {
+	t.Run("recreates boards, columns, cards and card state", func(t *testing.T) {
+		server := newRestoreServer(t, nil)
+		defer server.Close()
+
+		a := writeTestArchive(t)
+		client, _ := fizzy.NewClient("/target", "test-token", fizzy.WithBaseURL(server.URL))
+		report, err := Restore(context.Background(), client, a, RestoreOptions{})
+
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		expected := []string{
+			"POST /boards",
+			"POST /boards/new-board/columns",
+			"POST /boards/new-board/cards",
+			"POST /cards/501/taggings",
+			"POST /cards/501/steps",
+			"POST /cards/501/comments",
+			"POST /cards/501/triage",
+			"POST /cards/501/closure",
+			"POST /cards/501/goldness",
+			"PUT /cards/501",
+		}
+		if got := server.mutations(); strings.Join(got, "\n") != strings.Join(expected, "\n") {
+			t.Errorf("unexpected calls:\n%s", strings.Join(got, "\n"))
+		}
+		if report.Journal.Cards["old-card"].Number != 501 {
+			t.Errorf("expected old-card to map to 501, got %+v", report.Journal.Cards["old-card"])
+		}
+		if report.Journal.Columns["old-col"] != "new-col" {
+			t.Errorf("expected old-col to map to new-col, got %s", report.Journal.Columns["old-col"])
+		}
+	})
+
+	t.Run("dry run performs no mutations", func(t *testing.T) {
+		server := newRestoreServer(t, nil)
+		defer server.Close()
+
+		a := writeTestArchive(t)
+		client, _ := fizzy.NewClient("/target", "test-token", fizzy.WithBaseURL(server.URL))
+		report, err := Restore(context.Background(), client, a, RestoreOptions{DryRun: true})
+
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got := server.mutations(); len(got) != 0 {
+			t.Errorf("expected no mutations, got %v", got)
+		}
+		if len(report.Actions) != 10 {
+			t.Errorf("expected 10 planned actions, got %d: %v", len(report.Actions), report.Actions)
+		}
+		if _, err := os.Stat(filepath.Join(a.Dir, journalFile)); !os.IsNotExist(err) {
+			t.Error("expected no journal to be written in dry run")
+		}
+	})
+
+	t.Run("fails on board name conflict", func(t *testing.T) {
+		server := newRestoreServer(t, []fizzy.Board{{ID: "b", Name: "Product"}})
+		defer server.Close()
+
+		a := writeTestArchive(t)
+		client, _ := fizzy.NewClient("/target", "test-token", fizzy.WithBaseURL(server.URL))
+		_, err := Restore(context.Background(), client, a, RestoreOptions{})
+
+		if !errors.Is(err, ErrConflict) {
+			t.Errorf("expected ErrConflict, got %v", err)
+		}
+		if got := server.mutations(); len(got) != 0 {
+			t.Errorf("expected no mutations, got %v", got)
+		}
+	})
+
+	t.Run("skips conflicting boards with ConflictSkip", func(t *testing.T) {
+		server := newRestoreServer(t, []fizzy.Board{{ID: "b", Name: "Product"}})
+		defer server.Close()
+
+		a := writeTestArchive(t)
+		client, _ := fizzy.NewClient("/target", "test-token", fizzy.WithBaseURL(server.URL))
+		report, err := Restore(context.Background(), client, a, RestoreOptions{OnConflict: ConflictSkip})
+
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(report.Conflicts) != 1 || len(server.mutations()) != 0 {
+			t.Errorf("expected the board and its cards to be skipped, got %v", server.mutations())
+		}
+	})
+
+	t.Run("resumes from the journal", func(t *testing.T) {
+		server := newRestoreServer(t, nil)
+		defer server.Close()
+
+		a := writeTestArchive(t)
+		client, _ := fizzy.NewClient("/target", "test-token", fizzy.WithBaseURL(server.URL))
+		if _, err := Restore(context.Background(), client, a, RestoreOptions{}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		before := len(server.mutations())
+		if _, err := Restore(context.Background(), client, a, RestoreOptions{}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if after := len(server.mutations()); after != before {
+			t.Errorf("expected resumed restore to do nothing, got %d new calls", after-before)
+		}
+	})
+
+	t.Run("does not untag a card tagged before the journal was written", func(t *testing.T) {
+		server := newRestoreServer(t, nil)
+		defer server.Close()
+		server.tags = []string{"bug"}
+
+		a := writeTestArchive(t)
+		writeJSON(filepath.Join(a.Dir, journalFile), Journal{
+			Boards:  map[string]string{"old-board": "new-board"},
+			Columns: map[string]string{"old-col": "new-col"},
+			Cards:   map[string]*CardProgress{"old-card": {Number: 501}},
+		})
+		client, _ := fizzy.NewClient("/target", "test-token", fizzy.WithBaseURL(server.URL))
+		report, err := Restore(context.Background(), client, a, RestoreOptions{})
+
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		for _, call := range server.mutations() {
+			if call == "POST /cards/501/taggings" {
+				t.Error("expected the existing tag to be left alone")
+			}
+		}
+		if report.Journal.Cards["old-card"].Tags != 1 {
+			t.Errorf("expected tag progress to be recorded, got %+v", report.Journal.Cards["old-card"])
+		}
+	})
+}
@@ -0,0 +1,189 @@
+// Package automation runs rules against card activity.
+//
+// A rule names the events that trigger it, conditions the card has to meet
+// and the actions to take on it:
+//
+//	rules:
+//	  - name: Triage bugs
+//	    on: [card_published]
+//	    if:
+//	      boards: [Product]
+//	      tags: [bug]
+//	    do:
+//	      - assign: user-on-call
+//	      - step: Reproduce the bug
+//	      - comment: "Assigned to on-call, see {{.Card.URL}}"
+//
+// Events reach the Engine from a webhook, see Engine.WebhookHandler, or by
+// polling the activity feed, see Engine.Poll and Engine.Run:
+//
+//	cfg, err := automation.Load(file)
+//	engine := automation.New(client, cfg, automation.Options{SelfID: botUserID})
+//	err = engine.Run(ctx, time.Minute, time.Now())
+package automation
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/rogeriopvl/fizzy-go/internal/shared"
+	"gopkg.in/yaml.v3"
+)
+
+// Config is a set of rules.
+type Config struct {
+	Rules []Rule `json:"rules" yaml:"rules"`
+}
+
+// Rule runs its actions on the card of every event it is triggered by,
+// when the card meets its conditions.
+type Rule struct {
+	Name string `json:"name" yaml:"name"`
+
+	// On lists the event actions that trigger the rule, such as
+	// fizzy.ActionCardPublished. Every card event triggers it when empty.
+	On []string `json:"on,omitempty" yaml:"on,omitempty"`
+
+	If Conditions `json:"if" yaml:"if"`
+	Do []Action   `json:"do" yaml:"do"`
+}
+
+// Conditions are checked against the card an event is about. Empty fields
+// match any card; all non-empty fields have to match.
+type Conditions struct {
+	// Boards lists board names or IDs the card has to be on.
+	Boards []string `json:"boards,omitempty" yaml:"boards,omitempty"`
+
+	// Columns lists column names or IDs the card has to be in. Triage
+	// matches cards awaiting triage.
+	Columns []string `json:"columns,omitempty" yaml:"columns,omitempty"`
+	Triage  *bool    `json:"triage,omitempty" yaml:"triage,omitempty"`
+
+	// Tags lists tags the card must all have and NotTags tags it must have
+	// none of. A leading "#" is ignored.
+	Tags    []string `json:"tags,omitempty" yaml:"tags,omitempty"`
+	NotTags []string `json:"not_tags,omitempty" yaml:"not_tags,omitempty"`
+
+	// Assignees lists user IDs of which at least one has to be assigned.
+	// Assigned matches on whether the card has any assignee.
+	Assignees []string `json:"assignees,omitempty" yaml:"assignees,omitempty"`
+	Assigned  *bool    `json:"assigned,omitempty" yaml:"assigned,omitempty"`
+
+	// Creators lists user IDs of which one has to have created the card.
+	Creators []string `json:"creators,omitempty" yaml:"creators,omitempty"`
+
+	// TitleContains matches case-insensitively against the card title.
+	TitleContains string `json:"title_contains,omitempty" yaml:"title_contains,omitempty"`
+
+	Golden *bool `json:"golden,omitempty" yaml:"golden,omitempty"`
+	Closed *bool `json:"closed,omitempty" yaml:"closed,omitempty"`
+}
+
+// Action is one thing a rule does to a card. Exactly one field is set.
+// Step and Comment are text/template templates executed with the card, the
+// triggering event and the rule name as .Card, .Event and .Rule.
+type Action struct {
+	// Assign assigns the user with this ID, if not assigned yet.
+	Assign string `json:"assign,omitempty" yaml:"assign,omitempty"`
+	// Tag adds this tag, if missing.
+	Tag string `json:"tag,omitempty" yaml:"tag,omitempty"`
+	// Triage places the card in the column with this name or ID.
+	Triage   string `json:"triage,omitempty" yaml:"triage,omitempty"`
+	Step     string `json:"step,omitempty" yaml:"step,omitempty"`
+	Comment  string `json:"comment,omitempty" yaml:"comment,omitempty"`
+	Close    bool   `json:"close,omitempty" yaml:"close,omitempty"`
+	Postpone bool   `json:"postpone,omitempty" yaml:"postpone,omitempty"`
+}
+
+// Kind names the field that is set, or returns "" for an empty action.
+func (a Action) Kind() string {
+	kinds := a.kinds()
+	if len(kinds) != 1 {
+		return ""
+	}
+	return kinds[0]
+}
+
+func (a Action) kinds() []string {
+	var kinds []string
+	for _, field := range []struct {
+		kind string
+		set  bool
+	}{
+		{"assign", a.Assign != ""},
+		{"tag", a.Tag != ""},
+		{"triage", a.Triage != ""},
+		{"step", a.Step != ""},
+		{"comment", a.Comment != ""},
+		{"close", a.Close},
+		{"postpone", a.Postpone},
+	} {
+		if field.set {
+			kinds = append(kinds, field.kind)
+		}
+	}
+	return kinds
+}
+
+func (a Action) String() string {
+	switch a.Kind() {
+	case "assign":
+		return "assign " + a.Assign
+	case "tag":
+		return "tag " + a.Tag
+	case "triage":
+		return "triage to " + a.Triage
+	case "step":
+		return fmt.Sprintf("add step %q", a.Step)
+	case "comment":
+		return fmt.Sprintf("comment %q", a.Comment)
+	}
+	return a.Kind()
+}
+
+// Load decodes a Config from YAML or JSON and validates it.
+func Load(r io.Reader) (*Config, error) {
+	var cfg Config
+	if err := yaml.NewDecoder(r).Decode(&cfg); err != nil && err != io.EOF {
+		return nil, fmt.Errorf("failed to decode automation config: %w", err)
+	}
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	return &cfg, nil
+}
+
+// Validate checks for unnamed or duplicate rules, rules without actions,
+// actions that set no or several fields and invalid templates.
+func (cfg *Config) Validate() error {
+	names := make(map[string]bool, len(cfg.Rules))
+	for i, rule := range cfg.Rules {
+		if rule.Name == "" {
+			return fmt.Errorf("automation config: rule %d has no name", i+1)
+		}
+		if names[rule.Name] {
+			return fmt.Errorf("automation config: rule %q is defined twice", rule.Name)
+		}
+		names[rule.Name] = true
+
+		if len(rule.Do) == 0 {
+			return fmt.Errorf("automation config: rule %q has no actions", rule.Name)
+		}
+		for j, action := range rule.Do {
+			switch kinds := action.kinds(); len(kinds) {
+			case 0:
+				return fmt.Errorf("automation config: action %d of rule %q does nothing", j+1, rule.Name)
+			case 1:
+			default:
+				return fmt.Errorf("automation config: action %d of rule %q sets %s; use one action each", j+1, rule.Name, strings.Join(kinds, " and "))
+			}
+			for _, text := range []string{action.Step, action.Comment} {
+				if _, err := shared.ParseTemplate(text); err != nil {
+					return fmt.Errorf("automation config: action %d of rule %q: %w", j+1, rule.Name, err)
+				}
+			}
+		}
+	}
+	return nil
+}
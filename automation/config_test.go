@@ -0,0 +1,77 @@
+package automation
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestLoad(t *testing.T) {
+	t.Run("parses YAML", func(t *testing.T) {
+		cfg, err := Load(strings.NewReader(`
+rules:
+  - name: Triage bugs
+    on: [card_published]
+    if:
+      boards: [Product]
+      tags: ["#bug"]
+      assigned: false
+    do:
+      - assign: user-1
+      - step: Reproduce {{.Card.Title}}
+      - triage: Doing
+`))
+
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(cfg.Rules) != 1 {
+			t.Fatalf("expected 1 rule, got %d", len(cfg.Rules))
+		}
+		rule := cfg.Rules[0]
+		if rule.If.Assigned == nil || *rule.If.Assigned || len(rule.If.Tags) != 1 {
+			t.Errorf("unexpected conditions: %+v", rule.If)
+		}
+		if len(rule.Do) != 3 || rule.Do[0].Kind() != "assign" || rule.Do[2].Triage != "Doing" {
+			t.Errorf("unexpected actions: %+v", rule.Do)
+		}
+	})
+
+	t.Run("accepts an empty document", func(t *testing.T) {
+		cfg, err := Load(strings.NewReader(""))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(cfg.Rules) != 0 {
+			t.Errorf("expected no rules, got %+v", cfg.Rules)
+		}
+	})
+
+	for name, doc := range map[string]string{
+		"rejects unnamed rules":            "rules:\n  - do: [{close: true}]\n",
+		"rejects duplicate rules":          "rules:\n  - name: A\n    do: [{close: true}]\n  - name: A\n    do: [{close: true}]\n",
+		"rejects rules without actions":    "rules:\n  - name: A\n",
+		"rejects empty actions":            "rules:\n  - name: A\n    do: [{}]\n",
+		"rejects actions doing two things": "rules:\n  - name: A\n    do: [{close: true, tag: done}]\n",
+		"rejects invalid templates":        "rules:\n  - name: A\n    do: [{comment: \"{{.Card\"}]\n",
+	} {
+		t.Run(name, func(t *testing.T) {
+			if _, err := Load(strings.NewReader(doc)); err == nil {
+				t.Fatal("expected error, got nil")
+			}
+		})
+	}
+}
+
+func TestActionString(t *testing.T) {
+	for action, want := range map[Action]string{
+		{Assign: "user-1"}:      "assign user-1",
+		{Triage: "Doing"}:       "triage to Doing",
+		{Comment: "hi"}:         `comment "hi"`,
+		{Postpone: true}:        "postpone",
+		{Tag: "a", Close: true}: "",
+	} {
+		if got := action.String(); got != want {
+			t.Errorf("expected %q, got %q", want, got)
+		}
+	}
+}
@@ -0,0 +1,337 @@
+package automation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"path"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	fizzy "github.com/rogeriopvl/fizzy-go"
+	"github.com/rogeriopvl/fizzy-go/internal/shared"
+)
+
+const (
+	DefaultMaxRuns    = 5
+	DefaultLoopWindow = 10 * time.Minute
+
+	// seenTTL is how long handled event IDs are remembered, which covers
+	// webhook redeliveries and overlapping polls.
+	seenTTL = 24 * time.Hour
+)
+
+// ErrLoop is recorded instead of running a rule on a card that rules have
+// already run on MaxRuns times within LoopWindow, which usually means rules
+// keep triggering each other.
+var ErrLoop = errors.New("automation: too many runs on card, possible rule loop")
+
+// Options controls how an Engine runs rules.
+type Options struct {
+	// DryRun records the actions rules would take without taking them.
+	DryRun bool
+
+	// SelfID is the ID of the user the client acts as. Events created by
+	// this user, including those caused by the engine's own actions, are
+	// ignored.
+	SelfID string
+
+	// MaxRuns is how many times rules may run on one card within
+	// LoopWindow. They default to DefaultMaxRuns and DefaultLoopWindow.
+	MaxRuns    int
+	LoopWindow time.Duration
+
+	// OnExecution, when set, is called for every action taken or skipped,
+	// for logging.
+	OnExecution func(Execution)
+}
+
+// Execution records one action of a rule run on a card.
+type Execution struct {
+	Rule    string
+	EventID string
+	Card    int
+	Action  Action
+	// Text is the rendered step or comment.
+	Text    string
+	Applied bool
+	Err     error
+	At      time.Time
+}
+
+func (x Execution) String() string {
+	status := "ok"
+	switch {
+	case x.Err != nil:
+		status = "failed: " + x.Err.Error()
+	case !x.Applied:
+		status = "dry run"
+	}
+	action := x.Action.String()
+	if x.Text != "" {
+		action = fmt.Sprintf("%s %q", x.Action.Kind(), x.Text)
+	}
+	if errors.Is(x.Err, ErrLoop) {
+		action = "run"
+	}
+	return fmt.Sprintf("%s [%s] card #%d: %s: %s", x.At.UTC().Format(time.RFC3339), x.Rule, x.Card, action, status)
+}
+
+// Engine runs a Config's rules against events. It is safe for concurrent
+// use; events are handled one at a time.
+type Engine struct {
+	client *fizzy.Client
+	rules  []Rule
+	opts   Options
+
+	mu   sync.Mutex
+	seen map[string]time.Time
+	runs map[string][]time.Time
+	now  func() time.Time
+}
+
+func New(client *fizzy.Client, cfg *Config, opts Options) *Engine {
+	if opts.MaxRuns <= 0 {
+		opts.MaxRuns = DefaultMaxRuns
+	}
+	if opts.LoopWindow <= 0 {
+		opts.LoopWindow = DefaultLoopWindow
+	}
+	return &Engine{
+		client: client,
+		rules:  cfg.Rules,
+		opts:   opts,
+		seen:   make(map[string]time.Time),
+		runs:   make(map[string][]time.Time),
+		now:    time.Now,
+	}
+}
+
+// Handle runs the rules triggered by event whose conditions the event's
+// card meets. Conditions are checked against the card as it is when the
+// event is handled, before any rule acts on it. Events seen before, created
+// by Options.SelfID or not about a card are ignored.
+//
+// Failed actions are recorded in their Execution and stop the rest of
+// their rule; the returned error is only set when the card could not be
+// fetched, in which case the event is not marked as seen.
+func (e *Engine) Handle(ctx context.Context, event fizzy.Event) ([]Execution, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	info := event.Info()
+	now := e.now()
+	for id, at := range e.seen {
+		if now.Sub(at) > seenTTL {
+			delete(e.seen, id)
+		}
+	}
+	if _, seen := e.seen[info.ID]; seen && info.ID != "" {
+		return nil, nil
+	}
+	if info.Card.ID == "" || (e.opts.SelfID != "" && info.Creator.ID == e.opts.SelfID) {
+		e.markSeen(info.ID, now)
+		return nil, nil
+	}
+
+	var rules []Rule
+	for _, rule := range e.rules {
+		if len(rule.On) == 0 || slices.Contains(rule.On, info.Action) {
+			rules = append(rules, rule)
+		}
+	}
+	if len(rules) == 0 {
+		e.markSeen(info.ID, now)
+		return nil, nil
+	}
+
+	card, err := e.fetchCard(ctx, info.Card)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get card %s: %w", info.Card.ID, err)
+	}
+	e.markSeen(info.ID, now)
+	if card == nil {
+		return nil, nil // deleted since
+	}
+
+	var executions []Execution
+	for _, rule := range rules {
+		if !rule.If.match(card) {
+			continue
+		}
+		if !e.allowRun(card.ID, now) {
+			executions = append(executions, e.record(Execution{Rule: rule.Name, EventID: info.ID, Card: card.Number, Err: ErrLoop, At: now}))
+			continue
+		}
+		executions = append(executions, e.run(ctx, rule, info, card)...)
+	}
+	return executions, nil
+}
+
+func (e *Engine) run(ctx context.Context, rule Rule, info fizzy.EventInfo, card *fizzy.Card) []Execution {
+	data := struct {
+		Card  *fizzy.Card
+		Event fizzy.EventInfo
+		Rule  string
+	}{card, info, rule.Name}
+
+	var executions []Execution
+	for _, action := range rule.Do {
+		x := Execution{Rule: rule.Name, EventID: info.ID, Card: card.Number, Action: action}
+		if text := action.Step + action.Comment; text != "" {
+			x.Text, x.Err = shared.Render(text, data)
+		}
+		if x.Err == nil && !e.opts.DryRun {
+			x.Err = e.apply(ctx, card, action, x.Text)
+			x.Applied = x.Err == nil
+		}
+		x.At = e.now()
+		executions = append(executions, e.record(x))
+		if x.Err != nil {
+			break
+		}
+	}
+	return executions
+}
+
+func (e *Engine) apply(ctx context.Context, card *fizzy.Card, action Action, text string) error {
+	switch action.Kind() {
+	case "assign":
+		return e.client.EnsureAssigned(ctx, card.Number, action.Assign)
+	case "tag":
+		return e.client.EnsureTagged(ctx, card.Number, action.Tag)
+	case "triage":
+		columns, err := e.client.ForBoard(card.Board.ID).GetColumns(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to list columns: %w", err)
+		}
+		i := slices.IndexFunc(columns, func(column fizzy.Column) bool {
+			return shared.MatchName(action.Triage, column.ID, column.Name)
+		})
+		if i < 0 {
+			return fmt.Errorf("column %q not found on board %s", action.Triage, card.Board.Name)
+		}
+		return e.client.TriageCard(ctx, card.Number, columns[i].ID)
+	case "step":
+		_, err := e.client.CreateCardStep(ctx, card.Number, text, false)
+		return err
+	case "comment":
+		_, err := e.client.CreateCardComment(ctx, card.Number, text)
+		return err
+	case "close":
+		return e.client.CloseCard(ctx, card.Number)
+	case "postpone":
+		return e.client.PostponeCard(ctx, card.Number)
+	}
+	return fmt.Errorf("invalid action %+v", action)
+}
+
+func (e *Engine) record(x Execution) Execution {
+	if e.opts.OnExecution != nil {
+		e.opts.OnExecution(x)
+	}
+	return x
+}
+
+func (e *Engine) markSeen(eventID string, now time.Time) {
+	if eventID != "" {
+		e.seen[eventID] = now
+	}
+}
+
+// allowRun records a rule run on a card unless the card has reached
+// MaxRuns within LoopWindow.
+func (e *Engine) allowRun(cardID string, now time.Time) bool {
+	runs := slices.DeleteFunc(e.runs[cardID], func(at time.Time) bool {
+		return now.Sub(at) >= e.opts.LoopWindow
+	})
+	if len(runs) >= e.opts.MaxRuns {
+		e.runs[cardID] = runs
+		return false
+	}
+	e.runs[cardID] = append(runs, now)
+	return true
+}
+
+// fetchCard gets the card an event refers to, by the number in its URL or,
+// failing that, by ID. It returns nil if the card no longer exists.
+func (e *Engine) fetchCard(ctx context.Context, ref fizzy.CardReference) (*fizzy.Card, error) {
+	if number, err := strconv.Atoi(path.Base(ref.URL)); err == nil && number > 0 {
+		card, err := e.client.GetCard(ctx, number)
+		var apiErr *fizzy.APIError
+		if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound {
+			return nil, nil
+		}
+		return card, err
+	}
+
+	for _, index := range []string{"all", "closed", "not_now"} {
+		cards, err := e.client.GetCards(ctx, fizzy.CardFilters{CardIDs: []string{ref.ID}, IndexedBy: index})
+		if err != nil {
+			return nil, err
+		}
+		for i := range cards {
+			if cards[i].ID == ref.ID {
+				return &cards[i], nil
+			}
+		}
+	}
+	return nil, nil
+}
+
+func (c Conditions) match(card *fizzy.Card) bool {
+	if len(c.Boards) > 0 && !slices.ContainsFunc(c.Boards, func(board string) bool {
+		return shared.MatchName(board, card.Board.ID, card.Board.Name)
+	}) {
+		return false
+	}
+	if len(c.Columns) > 0 && (card.Column == nil || !slices.ContainsFunc(c.Columns, func(column string) bool {
+		return shared.MatchName(column, card.Column.ID, card.Column.Name)
+	})) {
+		return false
+	}
+	if c.Triage != nil && *c.Triage != (card.Column == nil) {
+		return false
+	}
+
+	tags := make([]string, len(card.Tags))
+	for i, tag := range card.Tags {
+		tags[i] = shared.TagKey(tag)
+	}
+	for _, tag := range c.Tags {
+		if !slices.Contains(tags, shared.TagKey(tag)) {
+			return false
+		}
+	}
+	for _, tag := range c.NotTags {
+		if slices.Contains(tags, shared.TagKey(tag)) {
+			return false
+		}
+	}
+
+	if len(c.Assignees) > 0 && !slices.ContainsFunc(card.Assignees, func(user fizzy.User) bool {
+		return slices.Contains(c.Assignees, user.ID)
+	}) {
+		return false
+	}
+	if c.Assigned != nil && *c.Assigned != (len(card.Assignees) > 0) {
+		return false
+	}
+	if len(c.Creators) > 0 && !slices.Contains(c.Creators, card.Creator.ID) {
+		return false
+	}
+	if c.TitleContains != "" && !strings.Contains(strings.ToLower(card.Title), strings.ToLower(c.TitleContains)) {
+		return false
+	}
+	if c.Golden != nil && *c.Golden != card.Golden {
+		return false
+	}
+	if c.Closed != nil && *c.Closed != card.Closed {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,232 @@
+package automation
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	fizzy "github.com/rogeriopvl/fizzy-go"
+)
+
+var testCard = fizzy.Card{
+	ID:      "c42",
+	Number:  42,
+	Title:   "Login broken",
+	Tags:    []string{"bug"},
+	Board:   fizzy.Board{ID: "board-1", Name: "Product"},
+	Creator: fizzy.User{ID: "user-9"},
+	URL:     "https://app.fizzy.do/test-account/cards/42",
+}
+
+// fakeFizzy serves testCard and records every change made to it.
+type fakeFizzy struct {
+	*httptest.Server
+	mu       sync.Mutex
+	requests []string
+	events   string
+}
+
+func newFakeFizzy(t *testing.T) *fakeFizzy {
+	f := &fakeFizzy{}
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /test-account/cards/42", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(testCard)
+	})
+	mux.HandleFunc("GET /test-account/cards/{number}", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+	mux.HandleFunc("GET /test-account/boards/board-1/columns", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode([]fizzy.Column{{ID: "col-1", Name: "Doing"}})
+	})
+	mux.HandleFunc("GET /test-account/events", func(w http.ResponseWriter, r *http.Request) {
+		io.WriteString(w, f.events)
+	})
+	mux.HandleFunc("POST /test-account/cards/42/{change}", func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		f.mu.Lock()
+		f.requests = append(f.requests, r.PathValue("change")+" "+strings.TrimSpace(string(body)))
+		f.mu.Unlock()
+
+		switch r.PathValue("change") {
+		case "steps", "comments":
+			w.Header().Set("Location", r.URL.Path+"/new-1")
+			w.WriteHeader(http.StatusCreated)
+		default:
+			w.WriteHeader(http.StatusNoContent)
+		}
+	})
+	f.Server = httptest.NewServer(mux)
+	t.Cleanup(f.Close)
+	return f
+}
+
+func (f *fakeFizzy) client() *fizzy.Client {
+	client, _ := fizzy.NewClient("/test-account", "test-token", fizzy.WithBaseURL(f.URL))
+	return client
+}
+
+func (f *fakeFizzy) changes() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]string(nil), f.requests...)
+}
+
+func testEvent(id, action, creatorID string) fizzy.Event {
+	event, _ := fizzy.DecodeEvent([]byte(`{"id": "` + id + `", "action": "` + action + `", "created_at": "2025-12-06T10:00:00Z",
+		"creator": {"id": "` + creatorID + `"}, "card": {"id": "c42", "url": "` + testCard.URL + `"}}`))
+	return event
+}
+
+func boolPtr(b bool) *bool { return &b }
+
+var bugRule = Rule{
+	Name: "Triage bugs",
+	On:   []string{fizzy.ActionCardPublished},
+	If:   Conditions{Boards: []string{"product"}, Tags: []string{"#Bug"}, Assigned: boolPtr(false)},
+	Do: []Action{
+		{Assign: "user-1"},
+		{Step: "Reproduce {{.Card.Title}}"},
+		{Triage: "Doing"},
+	},
+}
+
+func TestHandle(t *testing.T) {
+	t.Run("runs matching rules", func(t *testing.T) {
+		fake := newFakeFizzy(t)
+		var logged []Execution
+		engine := New(fake.client(), &Config{Rules: []Rule{bugRule}}, Options{OnExecution: func(x Execution) { logged = append(logged, x) }})
+
+		executions, err := engine.Handle(context.Background(), testEvent("e1", fizzy.ActionCardPublished, "user-9"))
+
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(executions) != 3 || len(logged) != 3 {
+			t.Fatalf("expected 3 executions, got %+v", executions)
+		}
+		if executions[1].Text != "Reproduce Login broken" || !executions[1].Applied {
+			t.Errorf("unexpected step execution: %+v", executions[1])
+		}
+		changes := fake.changes()
+		if len(changes) != 3 || !strings.HasPrefix(changes[0], "assignments ") || !strings.Contains(changes[2], `"col-1"`) {
+			t.Errorf("unexpected changes: %v", changes)
+		}
+	})
+
+	t.Run("skips rules that do not match", func(t *testing.T) {
+		fake := newFakeFizzy(t)
+		rules := []Rule{
+			{Name: "other trigger", On: []string{fizzy.ActionCardClosed}, Do: []Action{{Close: true}}},
+			{Name: "other board", If: Conditions{Boards: []string{"Ops"}}, Do: []Action{{Close: true}}},
+			{Name: "excluded tag", If: Conditions{NotTags: []string{"bug"}}, Do: []Action{{Close: true}}},
+			{Name: "in a column", If: Conditions{Columns: []string{"Doing"}}, Do: []Action{{Close: true}}},
+			{Name: "title", If: Conditions{TitleContains: "LOGIN", Triage: boolPtr(true)}, Do: []Action{{Postpone: true}}},
+		}
+		engine := New(fake.client(), &Config{Rules: rules}, Options{})
+
+		executions, err := engine.Handle(context.Background(), testEvent("e1", fizzy.ActionCardPublished, "user-9"))
+
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(executions) != 1 || executions[0].Rule != "title" {
+			t.Errorf("expected only the title rule to run, got %+v", executions)
+		}
+	})
+
+	t.Run("makes no changes on dry run", func(t *testing.T) {
+		fake := newFakeFizzy(t)
+		engine := New(fake.client(), &Config{Rules: []Rule{bugRule}}, Options{DryRun: true})
+
+		executions, _ := engine.Handle(context.Background(), testEvent("e1", fizzy.ActionCardPublished, "user-9"))
+
+		if len(executions) != 3 || executions[0].Applied || executions[1].Text == "" {
+			t.Errorf("expected 3 unapplied executions, got %+v", executions)
+		}
+		if changes := fake.changes(); len(changes) != 0 {
+			t.Errorf("expected no changes, got %v", changes)
+		}
+	})
+
+	t.Run("ignores repeated and own events", func(t *testing.T) {
+		fake := newFakeFizzy(t)
+		engine := New(fake.client(), &Config{Rules: []Rule{bugRule}}, Options{SelfID: "bot"})
+
+		engine.Handle(context.Background(), testEvent("e1", fizzy.ActionCardPublished, "user-9"))
+		again, _ := engine.Handle(context.Background(), testEvent("e1", fizzy.ActionCardPublished, "user-9"))
+		own, _ := engine.Handle(context.Background(), testEvent("e2", fizzy.ActionCardPublished, "bot"))
+
+		if len(again) != 0 || len(own) != 0 {
+			t.Errorf("expected no executions, got %+v and %+v", again, own)
+		}
+		if changes := fake.changes(); len(changes) != 3 {
+			t.Errorf("expected only the first event to make changes, got %v", changes)
+		}
+	})
+
+	t.Run("stops rule loops", func(t *testing.T) {
+		fake := newFakeFizzy(t)
+		rule := Rule{Name: "ping", Do: []Action{{Comment: "ping"}}}
+		engine := New(fake.client(), &Config{Rules: []Rule{rule}}, Options{MaxRuns: 2, LoopWindow: time.Hour})
+
+		var last []Execution
+		for _, id := range []string{"e1", "e2", "e3"} {
+			last, _ = engine.Handle(context.Background(), testEvent(id, fizzy.ActionCommentCreated, "user-9"))
+		}
+
+		if len(last) != 1 || !errors.Is(last[0].Err, ErrLoop) {
+			t.Errorf("expected the third run to be stopped, got %+v", last)
+		}
+		if changes := fake.changes(); len(changes) != 2 {
+			t.Errorf("expected 2 comments, got %v", changes)
+		}
+	})
+
+	t.Run("stops a rule at the first failed action", func(t *testing.T) {
+		fake := newFakeFizzy(t)
+		rule := Rule{Name: "move", Do: []Action{{Triage: "Missing"}, {Close: true}}}
+		engine := New(fake.client(), &Config{Rules: []Rule{rule}}, Options{})
+
+		executions, err := engine.Handle(context.Background(), testEvent("e1", fizzy.ActionCardPublished, "user-9"))
+
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(executions) != 1 || executions[0].Err == nil {
+			t.Errorf("expected one failed execution, got %+v", executions)
+		}
+	})
+
+	t.Run("ignores deleted cards", func(t *testing.T) {
+		fake := newFakeFizzy(t)
+		engine := New(fake.client(), &Config{Rules: []Rule{bugRule}}, Options{})
+		event, _ := fizzy.DecodeEvent([]byte(`{"id": "e1", "action": "card_published", "card": {"id": "c7", "url": "/cards/7"}}`))
+
+		executions, err := engine.Handle(context.Background(), event)
+
+		if err != nil || len(executions) != 0 {
+			t.Errorf("expected nothing to happen, got %+v, %v", executions, err)
+		}
+	})
+
+	t.Run("returns error on failure", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusInternalServerError)
+		}))
+		defer server.Close()
+
+		client, _ := fizzy.NewClient("/test-account", "test-token", fizzy.WithBaseURL(server.URL))
+		engine := New(client, &Config{Rules: []Rule{bugRule}}, Options{})
+
+		if _, err := engine.Handle(context.Background(), testEvent("e1", fizzy.ActionCardPublished, "user-9")); err == nil {
+			t.Error("expected error, got nil")
+		}
+	})
+}
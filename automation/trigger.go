@@ -0,0 +1,174 @@
+package automation
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"time"
+
+	fizzy "github.com/rogeriopvl/fizzy-go"
+)
+
+// maxWebhookBody caps the size of webhook deliveries read by the handler.
+const maxWebhookBody = 1 << 20
+
+// WebhookHandler returns an http.Handler that handles events POSTed to it
+// in the activity feed's format. Requests have to carry an
+// X-Webhook-Signature header holding the hex HMAC-SHA256 of the body with
+// secret. An empty secret refuses every request with 500, as the handler
+// would otherwise let anyone run the rules' actions.
+//
+// It responds 200 with the executions as JSON once the rules have run, and
+// 502 if the event's card could not be fetched so the sender retries.
+func (e *Engine) WebhookHandler(secret string) http.Handler {
+	if secret == "" {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			http.Error(w, "webhook secret not configured", http.StatusInternalServerError)
+		})
+	}
+	return e.webhookHandler(secret)
+}
+
+// UnauthenticatedWebhookHandler is WebhookHandler without signature checks,
+// for deployments where something in front of it, such as an
+// authenticating proxy, already vouches for every request.
+func (e *Engine) UnauthenticatedWebhookHandler() http.Handler {
+	return e.webhookHandler("")
+}
+
+// webhookHandler handles deliveries, checking their signature when secret
+// is set.
+func (e *Engine) webhookHandler(secret string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			w.Header().Set("Allow", http.MethodPost)
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		body, err := io.ReadAll(io.LimitReader(r.Body, maxWebhookBody))
+		if err != nil {
+			http.Error(w, "failed to read body", http.StatusBadRequest)
+			return
+		}
+		if secret != "" && !validSignature(secret, body, r.Header.Get("X-Webhook-Signature")) {
+			http.Error(w, "invalid signature", http.StatusUnauthorized)
+			return
+		}
+
+		event, err := fizzy.DecodeEvent(body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		executions, err := e.Handle(r.Context(), event)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadGateway)
+			return
+		}
+
+		type result struct {
+			Rule    string `json:"rule"`
+			Card    int    `json:"card"`
+			Action  string `json:"action"`
+			Applied bool   `json:"applied"`
+			Error   string `json:"error,omitempty"`
+		}
+		results := make([]result, len(executions))
+		for i, x := range executions {
+			results[i] = result{Rule: x.Rule, Card: x.Card, Action: x.Action.String(), Applied: x.Applied}
+			if x.Err != nil {
+				results[i].Error = x.Err.Error()
+			}
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(results)
+	})
+}
+
+func validSignature(secret string, body []byte, signature string) bool {
+	got, err := hex.DecodeString(signature)
+	if err != nil {
+		return false
+	}
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return hmac.Equal(got, mac.Sum(nil))
+}
+
+// Poll handles the activity feed's events since the given time, oldest
+// first, and returns the time of the newest one to pass as since on the
+// next call. Events at exactly since are fetched again but, having been
+// handled, are skipped. On error it returns the time of the last event
+// handled, so polling can resume from there.
+func (e *Engine) Poll(ctx context.Context, since time.Time) ([]Execution, time.Time, error) {
+	if since.IsZero() {
+		return nil, since, errors.New("automation: polling needs a start time")
+	}
+
+	filters := fizzy.EventFilters{Since: since}
+	for _, rule := range e.rules {
+		if len(rule.On) == 0 {
+			filters.Actions = nil
+			break
+		}
+		for _, action := range rule.On {
+			if !slices.Contains(filters.Actions, action) {
+				filters.Actions = append(filters.Actions, action)
+			}
+		}
+	}
+
+	events, err := e.client.GetEvents(ctx, filters)
+	if err != nil {
+		return nil, since, fmt.Errorf("failed to list events: %w", err)
+	}
+
+	var executions []Execution
+	cursor := since
+	for _, event := range slices.Backward(events) {
+		handled, err := e.Handle(ctx, event)
+		executions = append(executions, handled...)
+		if err != nil {
+			return executions, cursor, err
+		}
+		if at := event.Info().Time(); at.After(cursor) {
+			cursor = at
+		}
+	}
+	return executions, cursor, nil
+}
+
+// Run polls every interval, starting with events since the given time,
+// until ctx is done. Temporary API errors, see fizzy.APIError.Temporary,
+// are retried on the next poll; other errors stop it.
+func (e *Engine) Run(ctx context.Context, interval time.Duration, since time.Time) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		var err error
+		_, since, err = e.Poll(ctx, since)
+		var apiErr *fizzy.APIError
+		if err != nil && !(errors.As(err, &apiErr) && apiErr.Temporary()) {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,175 @@
+package automation
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	fizzy "github.com/rogeriopvl/fizzy-go"
+)
+
+func TestWebhookHandler(t *testing.T) {
+	body := `{"id": "e1", "action": "card_published", "card": {"id": "c42", "url": "` + testCard.URL + `"}}`
+	sign := func(s string) string {
+		mac := hmac.New(sha256.New, []byte("secret"))
+		mac.Write([]byte(s))
+		return hex.EncodeToString(mac.Sum(nil))
+	}
+
+	t.Run("handles signed events", func(t *testing.T) {
+		fake := newFakeFizzy(t)
+		engine := New(fake.client(), &Config{Rules: []Rule{bugRule}}, Options{})
+
+		req := httptest.NewRequest(http.MethodPost, "/hooks/fizzy", strings.NewReader(body))
+		req.Header.Set("X-Webhook-Signature", sign(body))
+		rec := httptest.NewRecorder()
+		engine.WebhookHandler("secret").ServeHTTP(rec, req)
+
+		if rec.Code != http.StatusOK {
+			t.Fatalf("expected 200, got %d: %s", rec.Code, rec.Body)
+		}
+		var results []struct {
+			Rule    string `json:"rule"`
+			Applied bool   `json:"applied"`
+		}
+		json.NewDecoder(rec.Body).Decode(&results)
+		if len(results) != 3 || results[0].Rule != "Triage bugs" || !results[0].Applied {
+			t.Errorf("unexpected results: %+v", results)
+		}
+	})
+
+	for name, tc := range map[string]struct {
+		method, body, signature string
+		want                    int
+	}{
+		"rejects other methods":  {http.MethodGet, "", "", http.StatusMethodNotAllowed},
+		"rejects bad signatures": {http.MethodPost, body, sign("other"), http.StatusUnauthorized},
+		"rejects invalid events": {http.MethodPost, `{}`, sign(`{}`), http.StatusBadRequest},
+	} {
+		t.Run(name, func(t *testing.T) {
+			fake := newFakeFizzy(t)
+			engine := New(fake.client(), &Config{Rules: []Rule{bugRule}}, Options{})
+
+			req := httptest.NewRequest(tc.method, "/hooks/fizzy", strings.NewReader(tc.body))
+			req.Header.Set("X-Webhook-Signature", tc.signature)
+			rec := httptest.NewRecorder()
+			engine.WebhookHandler("secret").ServeHTTP(rec, req)
+
+			if rec.Code != tc.want {
+				t.Errorf("expected %d, got %d", tc.want, rec.Code)
+			}
+			if changes := fake.changes(); len(changes) != 0 {
+				t.Errorf("expected no changes, got %v", changes)
+			}
+		})
+	}
+
+	t.Run("refuses requests without a secret", func(t *testing.T) {
+		fake := newFakeFizzy(t)
+		engine := New(fake.client(), &Config{Rules: []Rule{bugRule}}, Options{})
+
+		req := httptest.NewRequest(http.MethodPost, "/hooks/fizzy", strings.NewReader(body))
+		rec := httptest.NewRecorder()
+		engine.WebhookHandler("").ServeHTTP(rec, req)
+
+		if rec.Code != http.StatusInternalServerError {
+			t.Errorf("expected 500, got %d", rec.Code)
+		}
+		if changes := fake.changes(); len(changes) != 0 {
+			t.Errorf("expected no changes, got %v", changes)
+		}
+	})
+
+	t.Run("handles unsigned events when explicitly unauthenticated", func(t *testing.T) {
+		fake := newFakeFizzy(t)
+		engine := New(fake.client(), &Config{Rules: []Rule{bugRule}}, Options{})
+
+		req := httptest.NewRequest(http.MethodPost, "/hooks/fizzy", strings.NewReader(body))
+		rec := httptest.NewRecorder()
+		engine.UnauthenticatedWebhookHandler().ServeHTTP(rec, req)
+
+		if rec.Code != http.StatusOK {
+			t.Errorf("expected 200, got %d: %s", rec.Code, rec.Body)
+		}
+	})
+}
+
+func TestPoll(t *testing.T) {
+	t.Run("handles events oldest first", func(t *testing.T) {
+		fake := newFakeFizzy(t)
+		fake.events = `[
+			{"id": "e2", "action": "comment_created", "created_at": "2025-12-06T11:00:00Z", "card": {"id": "c42", "url": "/cards/42"}},
+			{"id": "e1", "action": "card_published", "created_at": "2025-12-06T10:00:00Z", "card": {"id": "c42", "url": "/cards/42"}}
+		]`
+		rules := []Rule{
+			{Name: "new", On: []string{fizzy.ActionCardPublished}, Do: []Action{{Comment: "new"}}},
+			{Name: "reply", On: []string{fizzy.ActionCommentCreated}, Do: []Action{{Comment: "reply"}}},
+		}
+		engine := New(fake.client(), &Config{Rules: rules}, Options{})
+
+		since := time.Date(2025, 12, 6, 9, 0, 0, 0, time.UTC)
+		executions, cursor, err := engine.Poll(context.Background(), since)
+
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(executions) != 2 || executions[0].Rule != "new" || executions[1].Rule != "reply" {
+			t.Errorf("unexpected executions: %+v", executions)
+		}
+		if !cursor.Equal(time.Date(2025, 12, 6, 11, 0, 0, 0, time.UTC)) {
+			t.Errorf("expected cursor at the newest event, got %v", cursor)
+		}
+
+		executions, _, _ = engine.Poll(context.Background(), cursor)
+		if len(executions) != 0 {
+			t.Errorf("expected handled events to be skipped, got %+v", executions)
+		}
+	})
+
+	t.Run("needs a start time", func(t *testing.T) {
+		fake := newFakeFizzy(t)
+		engine := New(fake.client(), &Config{}, Options{})
+
+		if _, _, err := engine.Poll(context.Background(), time.Time{}); err == nil {
+			t.Error("expected error, got nil")
+		}
+	})
+
+	t.Run("returns error on failure", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusUnauthorized)
+		}))
+		defer server.Close()
+
+		client, _ := fizzy.NewClient("/test-account", "test-token", fizzy.WithBaseURL(server.URL))
+		engine := New(client, &Config{}, Options{})
+
+		since := time.Now()
+		if _, cursor, err := engine.Poll(context.Background(), since); err == nil || !cursor.Equal(since) {
+			t.Errorf("expected error and unchanged cursor, got %v, %v", cursor, err)
+		}
+		if err := engine.Run(context.Background(), time.Millisecond, since); err == nil {
+			t.Error("expected Run to stop on a permanent error, got nil")
+		}
+	})
+}
+
+func TestRun(t *testing.T) {
+	fake := newFakeFizzy(t)
+	fake.events = `[]`
+	engine := New(fake.client(), &Config{}, Options{})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	if err := engine.Run(ctx, time.Millisecond, time.Now()); err != context.DeadlineExceeded {
+		t.Errorf("expected deadline exceeded, got %v", err)
+	}
+}
@@ -14,13 +14,7 @@ func (c *Client) GetBoards(ctx context.Context) ([]Board, error) {
 		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
 
-	var response []Board
-	_, err = c.decodeResponse(req, &response)
-	if err != nil {
-		return nil, err
-	}
-
-	return response, nil
+	return getAllPages[Board](c, req)
 }
 
 func (c *Client) GetBoard(ctx context.Context, boardID string) (*Board, error) {
@@ -54,6 +48,21 @@ func (c *Client) CreateBoard(ctx context.Context, payload CreateBoardPayload) er
 	return err
 }
 
+// CreateBoardAndGetID creates a board and returns its ID, read from the
+// Location header of the response.
+func (c *Client) CreateBoardAndGetID(ctx context.Context, payload CreateBoardPayload) (string, error) {
+	endpointURL := c.AccountBaseURL + "/boards"
+
+	body := map[string]CreateBoardPayload{"board": payload}
+
+	req, err := c.newRequest(ctx, http.MethodPost, endpointURL, body)
+	if err != nil {
+		return "", fmt.Errorf("failed to create board request: %w", err)
+	}
+
+	return c.createResource(req)
+}
+
 func (c *Client) UpdateBoard(ctx context.Context, boardID string, payload UpdateBoardPayload) error {
 	endpointURL := c.AccountBaseURL + "/boards/" + boardID
 
@@ -87,3 +96,30 @@ func (c *Client) DeleteBoard(ctx context.Context, boardID string) error {
 
 	return nil
 }
+
+// SetBoardAccess restricts a board to exactly the given users, replacing
+// its access list. The API does not report a board's current list, so
+// callers supply the whole of it. An empty list leaves only the board's
+// administrators with access.
+func (c *Client) SetBoardAccess(ctx context.Context, boardID string, userIDs []string) error {
+	endpointURL := c.AccountBaseURL + "/boards/" + boardID
+
+	if userIDs == nil {
+		userIDs = []string{}
+	}
+	// Sent without UpdateBoardPayload, whose user_ids is omitted when empty.
+	body := map[string]any{
+		"board": map[string]any{
+			"all_access": false,
+			"user_ids":   userIDs,
+		},
+	}
+
+	req, err := c.newRequest(ctx, http.MethodPut, endpointURL, body)
+	if err != nil {
+		return fmt.Errorf("failed to create set board access request: %w", err)
+	}
+
+	_, err = c.decodeResponse(req, nil, http.StatusNoContent)
+	return err
+}
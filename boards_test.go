@@ -116,6 +116,47 @@ func TestCreateBoard(t *testing.T) {
 	})
 }
 
+func TestCreateBoardAndGetID(t *testing.T) {
+	t.Run("returns ID from Location header", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodPost {
+				t.Errorf("expected POST, got %s", r.Method)
+			}
+			if r.URL.Path != "/test-account/boards" {
+				t.Errorf("unexpected path: %s", r.URL.Path)
+			}
+
+			w.Header().Set("Location", "/test-account/boards/board-9.json")
+			w.WriteHeader(http.StatusCreated)
+		}))
+		defer server.Close()
+
+		client, _ := NewClient("/test-account", "test-token", WithBaseURL(server.URL))
+		id, err := client.CreateBoardAndGetID(context.Background(), CreateBoardPayload{Name: "New Board"})
+
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if id != "board-9" {
+			t.Errorf("expected board ID 'board-9', got '%s'", id)
+		}
+	})
+
+	t.Run("returns error when Location header is missing", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusCreated)
+		}))
+		defer server.Close()
+
+		client, _ := NewClient("/test-account", "test-token", WithBaseURL(server.URL))
+		_, err := client.CreateBoardAndGetID(context.Background(), CreateBoardPayload{Name: "New Board"})
+
+		if err == nil {
+			t.Fatal("expected error, got nil")
+		}
+	})
+}
+
 func TestUpdateBoard(t *testing.T) {
 	t.Run("updates board on success", func(t *testing.T) {
 		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -161,3 +202,28 @@ func TestDeleteBoard(t *testing.T) {
 		}
 	})
 }
+
+func TestSetBoardAccess(t *testing.T) {
+	var updates []map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut || r.URL.Path != "/test-account/boards/board-1" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		var body map[string]map[string]any
+		json.NewDecoder(r.Body).Decode(&body)
+		updates = append(updates, body["board"])
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+	client, _ := NewClient("/test-account", "test-token", WithBaseURL(server.URL))
+
+	err := client.SetBoardAccess(context.Background(), "board-1", nil)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	ids, ok := updates[0]["user_ids"].([]any)
+	if !ok || len(ids) != 0 || updates[0]["all_access"] != false {
+		t.Errorf("expected empty user_ids to be sent, got %v", updates[0])
+	}
+}
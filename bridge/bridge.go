@@ -0,0 +1,146 @@
+// Package bridge forwards notifications to a team chat through an incoming
+// webhook.
+//
+// Each unread notification becomes a message in the webhook's format,
+// Slack or Matrix, and is marked read once delivered:
+//
+//	sink := &bridge.Sink{URL: "https://hooks.slack.com/services/...", Format: bridge.Slack}
+//	b := bridge.New(client, sink, bridge.NewMemoryStore(), bridge.Options{})
+//	err := b.Run(ctx, time.Minute)
+//
+// Deliveries are recorded in a Store, so that a notification is not sent
+// twice when marking it read fails or when Options.KeepUnread is set.
+package bridge
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"sort"
+	"time"
+
+	fizzy "github.com/rogeriopvl/fizzy-go"
+)
+
+// Options controls which notifications a Bridge delivers.
+type Options struct {
+	// Filters narrows the notifications delivered. ReadStatus defaults to
+	// unread notifications.
+	Filters fizzy.NotificationFilters
+
+	// KeepUnread leaves delivered notifications unread.
+	KeepUnread bool
+}
+
+// Bridge delivers notifications to a Sink.
+type Bridge struct {
+	client *fizzy.Client
+	sink   *Sink
+	store  Store
+	opts   Options
+	now    func() time.Time
+}
+
+func New(client *fizzy.Client, sink *Sink, store Store, opts Options) *Bridge {
+	if opts.Filters.ReadStatus == "" {
+		opts.Filters.ReadStatus = fizzy.NotificationsUnread
+	}
+	return &Bridge{client: client, sink: sink, store: store, opts: opts, now: time.Now}
+}
+
+// Poll delivers the notifications not delivered yet, oldest first, and
+// returns them. It stops at the first failed delivery, which is retried on
+// the next poll.
+//
+// Unless Options.KeepUnread is set, delivered notifications are then
+// marked read, along with any delivered earlier that are still unread.
+// Deliveries older than Retention are forgotten once their notifications
+// are no longer listed.
+func (b *Bridge) Poll(ctx context.Context) ([]fizzy.Notification, error) {
+	notifications, err := b.client.GetNotifications(ctx, b.opts.Filters)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list notifications: %w", err)
+	}
+	sort.SliceStable(notifications, func(i, j int) bool {
+		return notifications[i].CreatedAt < notifications[j].CreatedAt
+	})
+
+	var (
+		delivered []fizzy.Notification
+		read      []string
+		sendErr   error
+	)
+	for _, n := range notifications {
+		done, err := b.store.Delivered(n.ID)
+		if err != nil {
+			sendErr = err
+			break
+		}
+		if !done {
+			if err := b.sink.Send(ctx, NewMessage(n)); err != nil {
+				sendErr = fmt.Errorf("failed to deliver notification %s: %w", n.ID, err)
+				break
+			}
+			delivered = append(delivered, n)
+			if err := b.store.SetDelivered(n.ID, b.now()); err != nil {
+				sendErr = err
+				break
+			}
+		}
+		if !n.Read {
+			read = append(read, n.ID)
+		}
+	}
+
+	var readErr error
+	if !b.opts.KeepUnread && len(read) > 0 {
+		if err := b.client.MarkNotificationsRead(ctx, read); err != nil {
+			readErr = fmt.Errorf("failed to mark notifications read: %w", err)
+		}
+	}
+
+	listed := make(map[string]bool, len(notifications))
+	for _, n := range notifications {
+		listed[n.ID] = true
+	}
+	pruneErr := b.store.Prune(b.now().Add(-Retention), listed)
+	return delivered, errors.Join(sendErr, readErr, pruneErr)
+}
+
+// Run polls every interval until ctx is done. Temporary failures, such as
+// rate limiting or server errors from either side, or a webhook that
+// cannot be reached, are retried on the next poll; other errors stop it.
+func (b *Bridge) Run(ctx context.Context, interval time.Duration) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		_, err := b.Poll(ctx)
+		if err != nil && !temporary(err) {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+func temporary(err error) bool {
+	var apiErr *fizzy.APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.Temporary()
+	}
+	var sinkErr *SinkError
+	if errors.As(err, &sinkErr) {
+		return sinkErr.Temporary()
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr)
+}
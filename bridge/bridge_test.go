@@ -0,0 +1,186 @@
+package bridge
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	fizzy "github.com/rogeriopvl/fizzy-go"
+)
+
+// fakeFizzy serves notifications, unread first, and records readings.
+type fakeFizzy struct {
+	mu            sync.Mutex
+	notifications []fizzy.Notification
+	read          []string
+}
+
+func (f *fakeFizzy) server(t *testing.T) *httptest.Server {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /test-account/notifications", func(w http.ResponseWriter, r *http.Request) {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		var unread, read []fizzy.Notification
+		for _, n := range f.notifications {
+			n.Read = slices.Contains(f.read, n.ID)
+			if n.Read {
+				read = append(read, n)
+			} else {
+				unread = append(unread, n)
+			}
+		}
+		json.NewEncoder(w).Encode(append(unread, read...))
+	})
+	mux.HandleFunc("POST /test-account/notifications/{id}/reading", func(w http.ResponseWriter, r *http.Request) {
+		f.mu.Lock()
+		f.read = append(f.read, r.PathValue("id"))
+		f.mu.Unlock()
+		w.WriteHeader(http.StatusNoContent)
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return server
+}
+
+// sink records the Slack messages posted to it and fails with the given
+// status while fail returns true.
+func newSink(t *testing.T, received *[]string, fail func(text string) int) *Sink {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var msg SlackMessage
+		json.NewDecoder(r.Body).Decode(&msg)
+		if status := fail(msg.Text); status != 0 {
+			w.WriteHeader(status)
+			return
+		}
+		*received = append(*received, strings.SplitN(msg.Text, "\n", 2)[0])
+	}))
+	t.Cleanup(server.Close)
+	return &Sink{URL: server.URL, Format: Slack}
+}
+
+func testFizzy() *fakeFizzy {
+	return &fakeFizzy{notifications: []fizzy.Notification{
+		{ID: "n2", CreatedAt: "2025-12-06T11:00:00Z", Title: "Second"},
+		{ID: "n1", CreatedAt: "2025-12-06T10:00:00Z", Title: "First"},
+		{ID: "n3", CreatedAt: "2025-12-06T12:00:00Z", Title: "Third"},
+	}}
+}
+
+func noFailure(string) int { return 0 }
+
+func TestPoll(t *testing.T) {
+	t.Run("delivers oldest first and marks read", func(t *testing.T) {
+		f := testFizzy()
+		client, _ := fizzy.NewClient("/test-account", "test-token", fizzy.WithBaseURL(f.server(t).URL))
+		var received []string
+		b := New(client, newSink(t, &received, noFailure), NewMemoryStore(), Options{})
+
+		delivered, err := b.Poll(context.Background())
+
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(delivered) != 3 || !slices.Equal(received, []string{"First", "Second", "Third"}) {
+			t.Errorf("unexpected deliveries: %v", received)
+		}
+		slices.Sort(f.read)
+		if !slices.Equal(f.read, []string{"n1", "n2", "n3"}) {
+			t.Errorf("unexpected notifications marked read: %v", f.read)
+		}
+
+		f.notifications = append(f.notifications, fizzy.Notification{ID: "n4", CreatedAt: "2025-12-06T13:00:00Z", Title: "Fourth"})
+		delivered, err = b.Poll(context.Background())
+		if err != nil || len(delivered) != 1 || received[3] != "Fourth" {
+			t.Errorf("expected only the new notification on the next poll, got %v (%v)", received, err)
+		}
+	})
+
+	t.Run("retries failed deliveries on the next poll", func(t *testing.T) {
+		f := testFizzy()
+		client, _ := fizzy.NewClient("/test-account", "test-token", fizzy.WithBaseURL(f.server(t).URL))
+		var received []string
+		down := true
+		sink := newSink(t, &received, func(text string) int {
+			if down && strings.HasPrefix(text, "Second") {
+				return http.StatusServiceUnavailable
+			}
+			return 0
+		})
+		b := New(client, sink, NewMemoryStore(), Options{})
+
+		delivered, err := b.Poll(context.Background())
+
+		if err == nil || !temporary(err) {
+			t.Errorf("expected a temporary error, got %v", err)
+		}
+		if len(delivered) != 1 || !slices.Equal(f.read, []string{"n1"}) {
+			t.Errorf("expected only n1 delivered and read, got %v and %v", received, f.read)
+		}
+
+		down = false
+		if _, err := b.Poll(context.Background()); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !slices.Equal(received, []string{"First", "Second", "Third"}) {
+			t.Errorf("unexpected deliveries: %v", received)
+		}
+	})
+
+	t.Run("does not deliver twice when keeping notifications unread", func(t *testing.T) {
+		f := testFizzy()
+		client, _ := fizzy.NewClient("/test-account", "test-token", fizzy.WithBaseURL(f.server(t).URL))
+		var received []string
+		b := New(client, newSink(t, &received, noFailure), NewMemoryStore(), Options{KeepUnread: true})
+
+		b.Poll(context.Background())
+		delivered, err := b.Poll(context.Background())
+
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(delivered) != 0 || len(received) != 3 || len(f.read) != 0 {
+			t.Errorf("unexpected deliveries %v with %v read", received, f.read)
+		}
+	})
+
+	t.Run("remembers unread deliveries past the retention period", func(t *testing.T) {
+		f := testFizzy()
+		client, _ := fizzy.NewClient("/test-account", "test-token", fizzy.WithBaseURL(f.server(t).URL))
+		var received []string
+		b := New(client, newSink(t, &received, noFailure), NewMemoryStore(), Options{KeepUnread: true})
+
+		now := time.Date(2025, 12, 6, 13, 0, 0, 0, time.UTC)
+		b.now = func() time.Time { return now }
+		b.Poll(context.Background())
+		now = now.Add(2 * Retention)
+		delivered, err := b.Poll(context.Background())
+
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(delivered) != 0 || len(received) != 3 {
+			t.Errorf("expected no redelivery, got %v", received)
+		}
+	})
+
+	t.Run("returns error on failure", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusUnauthorized)
+		}))
+		defer server.Close()
+
+		client, _ := fizzy.NewClient("/test-account", "test-token", fizzy.WithBaseURL(server.URL))
+		var received []string
+		b := New(client, newSink(t, &received, noFailure), NewMemoryStore(), Options{})
+
+		if _, err := b.Poll(context.Background()); err == nil || temporary(err) {
+			t.Errorf("expected a permanent error, got %v", err)
+		}
+	})
+}
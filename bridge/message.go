@@ -0,0 +1,180 @@
+package bridge
+
+import (
+	"fmt"
+	"html"
+	"strings"
+
+	fizzy "github.com/rogeriopvl/fizzy-go"
+)
+
+// Format is the JSON payload format a chat's incoming webhook expects.
+type Format string
+
+const (
+	// Slack payloads also work with Mattermost, Rocket.Chat and other
+	// Slack-compatible webhooks.
+	Slack Format = "slack"
+	// Matrix payloads are m.room.message event contents, as accepted by
+	// Matrix webhook bridges such as matrix-hookshot.
+	Matrix Format = "matrix"
+)
+
+// Message holds the parts of a notification a chat message shows.
+type Message struct {
+	Title   string
+	Body    string
+	Creator string
+	// Card is the title of the notification's card, when it differs from
+	// Title.
+	Card string
+	URL  string
+}
+
+// NewMessage picks a notification's parts. Notifications without a title
+// use their card's, and the link falls back to the notification's own URL.
+func NewMessage(n fizzy.Notification) Message {
+	m := Message{
+		Title:   strings.TrimSpace(n.Title),
+		Body:    strings.TrimSpace(n.Body),
+		Creator: n.Creator.Name,
+		Card:    n.Card.Title,
+		URL:     n.Card.URL,
+	}
+	if m.Title == "" {
+		m.Title = m.Card
+	}
+	if m.Card == m.Title {
+		m.Card = ""
+	}
+	if m.URL == "" {
+		m.URL = n.URL
+	}
+	return m
+}
+
+// Text renders the message as plain text:
+//
+//	New comment
+//	Fix login
+//	Ana: Looks good to me
+//	https://app.fizzy.do/1/cards/1
+func (m Message) Text() string {
+	var lines []string
+	for _, line := range []string{m.Title, m.Card, m.byline(m.Body)} {
+		if line != "" {
+			lines = append(lines, line)
+		}
+	}
+	if m.URL != "" {
+		lines = append(lines, m.URL)
+	}
+	return strings.Join(lines, "\n")
+}
+
+func (m Message) byline(body string) string {
+	if m.Creator == "" || body == "" {
+		return body
+	}
+	return m.Creator + ": " + body
+}
+
+// SlackMessage is a Slack incoming webhook payload. Text is shown in
+// notifications and by clients that do not support blocks.
+type SlackMessage struct {
+	Text   string       `json:"text"`
+	Blocks []SlackBlock `json:"blocks,omitempty"`
+}
+
+type SlackBlock struct {
+	Type     string      `json:"type"`
+	Text     *SlackText  `json:"text,omitempty"`
+	Elements []SlackText `json:"elements,omitempty"`
+}
+
+type SlackText struct {
+	Type string `json:"type"`
+	Text string `json:"text"`
+}
+
+// Slack renders the message as a section with the linked title and the
+// body, followed by the card's title as context.
+func (m Message) Slack() SlackMessage {
+	title := "*" + escapeSlack(m.Title) + "*"
+	if m.URL != "" {
+		title = fmt.Sprintf("*<%s|%s>*", escapeSlack(m.URL), escapeSlack(m.Title))
+	}
+	section := title
+	if m.Body != "" {
+		body := escapeSlack(m.Body)
+		if m.Creator != "" {
+			body = "*" + escapeSlack(m.Creator) + "*: " + body
+		}
+		section += "\n" + body
+	}
+
+	msg := SlackMessage{
+		Text:   m.Text(),
+		Blocks: []SlackBlock{{Type: "section", Text: &SlackText{Type: "mrkdwn", Text: section}}},
+	}
+	if m.Card != "" {
+		msg.Blocks = append(msg.Blocks, SlackBlock{
+			Type:     "context",
+			Elements: []SlackText{{Type: "mrkdwn", Text: escapeSlack(m.Card)}},
+		})
+	}
+	return msg
+}
+
+var slackEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;")
+
+func escapeSlack(s string) string {
+	return slackEscaper.Replace(s)
+}
+
+// MatrixMessage is the content of a Matrix m.room.message event, with the
+// message as plain text in Body and as HTML in FormattedBody.
+type MatrixMessage struct {
+	MsgType       string `json:"msgtype"`
+	Body          string `json:"body"`
+	Format        string `json:"format,omitempty"`
+	FormattedBody string `json:"formatted_body,omitempty"`
+}
+
+// Matrix renders the message as a notice, so that bots ignore it.
+func (m Message) Matrix() MatrixMessage {
+	title := "<strong>" + html.EscapeString(m.Title) + "</strong>"
+	if m.URL != "" {
+		title = fmt.Sprintf(`<strong><a href="%s">%s</a></strong>`, html.EscapeString(m.URL), html.EscapeString(m.Title))
+	}
+	parts := []string{title}
+	if m.Card != "" {
+		parts = append(parts, "<em>"+html.EscapeString(m.Card)+"</em>")
+	}
+	if m.Body != "" {
+		body := html.EscapeString(m.Body)
+		if m.Creator != "" {
+			body = "<strong>" + html.EscapeString(m.Creator) + "</strong>: " + body
+		}
+		parts = append(parts, body)
+	}
+
+	return MatrixMessage{
+		MsgType:       "m.notice",
+		Body:          m.Text(),
+		Format:        "org.matrix.custom.html",
+		FormattedBody: strings.Join(parts, "<br>"),
+	}
+}
+
+// Payload returns the message in the given format, ready to be encoded as
+// JSON.
+func (m Message) Payload(format Format) (any, error) {
+	switch format {
+	case Slack:
+		return m.Slack(), nil
+	case Matrix:
+		return m.Matrix(), nil
+	}
+	return nil, fmt.Errorf("unknown message format %q", format)
+}
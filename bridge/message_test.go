@@ -0,0 +1,72 @@
+package bridge
+
+import (
+	"testing"
+
+	fizzy "github.com/rogeriopvl/fizzy-go"
+)
+
+var testNotification = fizzy.Notification{
+	ID:        "n1",
+	CreatedAt: "2025-12-06T10:00:00Z",
+	Title:     "New comment",
+	Body:      "Looks <good> & done",
+	Creator:   fizzy.User{Name: "Ana"},
+	Card:      fizzy.CardReference{ID: "c1", Title: "Fix login", URL: "https://app.fizzy.do/1/cards/1"},
+}
+
+func TestNewMessage(t *testing.T) {
+	m := NewMessage(testNotification)
+	if m.Title != "New comment" || m.Card != "Fix login" || m.URL != "https://app.fizzy.do/1/cards/1" {
+		t.Errorf("unexpected message: %+v", m)
+	}
+	if got := m.Text(); got != "New comment\nFix login\nAna: Looks <good> & done\nhttps://app.fizzy.do/1/cards/1" {
+		t.Errorf("unexpected text: %q", got)
+	}
+
+	m = NewMessage(fizzy.Notification{Card: fizzy.CardReference{Title: "Fix login"}, URL: "https://app.fizzy.do/1/notifications/1"})
+	if m.Title != "Fix login" || m.Card != "" || m.URL != "https://app.fizzy.do/1/notifications/1" {
+		t.Errorf("expected card title and notification URL as fallbacks, got %+v", m)
+	}
+}
+
+func TestMessageSlack(t *testing.T) {
+	msg := NewMessage(testNotification).Slack()
+
+	if msg.Text != NewMessage(testNotification).Text() {
+		t.Errorf("expected plain text fallback, got %q", msg.Text)
+	}
+	if len(msg.Blocks) != 2 || msg.Blocks[1].Type != "context" || msg.Blocks[1].Elements[0].Text != "Fix login" {
+		t.Fatalf("unexpected blocks: %+v", msg.Blocks)
+	}
+	if got := msg.Blocks[0].Text.Text; got != "*<https://app.fizzy.do/1/cards/1|New comment>*\n*Ana*: Looks &lt;good&gt; &amp; done" {
+		t.Errorf("unexpected section: %q", got)
+	}
+
+	m := Message{Title: "Search", URL: "https://app.fizzy.do/1/cards?q=<a>&page=2"}
+	if got := m.Slack().Blocks[0].Text.Text; got != "*<https://app.fizzy.do/1/cards?q=&lt;a&gt;&amp;page=2|Search>*" {
+		t.Errorf("expected the URL to be escaped, got %q", got)
+	}
+}
+
+func TestMessageMatrix(t *testing.T) {
+	msg := NewMessage(testNotification).Matrix()
+
+	if msg.MsgType != "m.notice" || msg.Format != "org.matrix.custom.html" || msg.Body != NewMessage(testNotification).Text() {
+		t.Errorf("unexpected message: %+v", msg)
+	}
+	want := `<strong><a href="https://app.fizzy.do/1/cards/1">New comment</a></strong><br><em>Fix login</em><br><strong>Ana</strong>: Looks &lt;good&gt; &amp; done`
+	if msg.FormattedBody != want {
+		t.Errorf("unexpected formatted body: %q", msg.FormattedBody)
+	}
+}
+
+func TestMessagePayload(t *testing.T) {
+	m := NewMessage(testNotification)
+	if payload, _ := m.Payload(Slack); payload == nil {
+		t.Error("expected a Slack payload")
+	}
+	if _, err := m.Payload("irc"); err == nil {
+		t.Error("expected error for unknown format, got nil")
+	}
+}
@@ -0,0 +1,88 @@
+package bridge
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	fizzy "github.com/rogeriopvl/fizzy-go"
+)
+
+// maxErrorBody caps how much of a failed response SinkError keeps.
+const maxErrorBody = 1024
+
+// defaultHTTPClient is used by Sinks without an HTTPClient, so a webhook
+// that never answers cannot stall the bridge.
+var defaultHTTPClient = &http.Client{Timeout: fizzy.DefaultTimeout}
+
+// Sink posts messages to a chat's incoming webhook.
+type Sink struct {
+	URL    string
+	Format Format
+	// Header is added to every request, for webhooks that need a token.
+	Header http.Header
+	// HTTPClient defaults to a client with fizzy.DefaultTimeout.
+	HTTPClient *http.Client
+}
+
+// SinkError is returned when a webhook responds with a status other than
+// 2xx.
+type SinkError struct {
+	StatusCode int
+	Body       string
+}
+
+func (e *SinkError) Error() string {
+	return fmt.Sprintf("webhook responded with status code %d: %s", e.StatusCode, e.Body)
+}
+
+// Temporary reports whether the delivery may succeed if retried: the
+// webhook was rate limiting or failed with a server error.
+func (e *SinkError) Temporary() bool {
+	return e.StatusCode == http.StatusTooManyRequests || e.StatusCode >= 500
+}
+
+// Send posts the message as JSON in the sink's format.
+func (s *Sink) Send(ctx context.Context, m Message) error {
+	payload, err := m.Payload(s.Format)
+	if err != nil {
+		return err
+	}
+	var body bytes.Buffer
+	enc := json.NewEncoder(&body)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(payload); err != nil {
+		return fmt.Errorf("failed to encode message: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.URL, &body)
+	if err != nil {
+		return fmt.Errorf("failed to create webhook request: %w", err)
+	}
+	for key, values := range s.Header {
+		for _, value := range values {
+			req.Header.Add(key, value)
+		}
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	client := s.HTTPClient
+	if client == nil {
+		client = defaultHTTPClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to post to webhook: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		data, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBody))
+		return &SinkError{StatusCode: resp.StatusCode, Body: string(data)}
+	}
+	io.Copy(io.Discard, resp.Body)
+	return nil
+}
@@ -0,0 +1,49 @@
+package bridge
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestSinkSend(t *testing.T) {
+	t.Run("posts the payload as JSON", func(t *testing.T) {
+		var got MatrixMessage
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodPost {
+				t.Errorf("expected POST, got %s", r.Method)
+			}
+			if r.Header.Get("Content-Type") != "application/json" || r.Header.Get("Authorization") != "Bearer hook-token" {
+				t.Errorf("unexpected headers: %v", r.Header)
+			}
+			json.NewDecoder(r.Body).Decode(&got)
+		}))
+		defer server.Close()
+
+		sink := &Sink{URL: server.URL, Format: Matrix, Header: http.Header{"Authorization": {"Bearer hook-token"}}}
+		if err := sink.Send(context.Background(), NewMessage(testNotification)); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got.Body != NewMessage(testNotification).Text() {
+			t.Errorf("unexpected message received: %+v", got)
+		}
+	})
+
+	t.Run("returns error on failure", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			http.Error(w, "invalid_payload", http.StatusBadRequest)
+		}))
+		defer server.Close()
+
+		sink := &Sink{URL: server.URL, Format: Slack}
+		err := sink.Send(context.Background(), NewMessage(testNotification))
+
+		var sinkErr *SinkError
+		if !errors.As(err, &sinkErr) || sinkErr.StatusCode != http.StatusBadRequest || sinkErr.Temporary() {
+			t.Errorf("expected a permanent SinkError, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,133 @@
+package bridge
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/rogeriopvl/fizzy-go/internal/shared"
+)
+
+// Retention is how long a delivery is remembered once its notification is
+// no longer listed.
+const Retention = 30 * 24 * time.Hour
+
+// Store remembers which notifications were delivered, so that none is sent
+// twice.
+type Store interface {
+	Delivered(notificationID string) (bool, error)
+	SetDelivered(notificationID string, at time.Time) error
+
+	// Prune forgets deliveries made before the given time, except those
+	// of the notifications in keep, which are still listed and would be
+	// delivered again.
+	Prune(before time.Time, keep map[string]bool) error
+}
+
+// MemoryStore keeps deliveries in memory, which is enough for a bridge that
+// marks notifications read.
+type MemoryStore struct {
+	mu        sync.Mutex
+	delivered map[string]time.Time
+}
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{delivered: make(map[string]time.Time)}
+}
+
+func (ms *MemoryStore) Delivered(notificationID string) (bool, error) {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+	_, ok := ms.delivered[notificationID]
+	return ok, nil
+}
+
+func (ms *MemoryStore) SetDelivered(notificationID string, at time.Time) error {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+	ms.delivered[notificationID] = at
+	return nil
+}
+
+func (ms *MemoryStore) Prune(before time.Time, keep map[string]bool) error {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+	prune(ms.delivered, before, keep)
+	return nil
+}
+
+// prune removes the deliveries made before the given time that are not in
+// keep, and reports whether it removed any.
+func prune(delivered map[string]time.Time, before time.Time, keep map[string]bool) bool {
+	pruned := false
+	for id, at := range delivered {
+		if at.Before(before) && !keep[id] {
+			delete(delivered, id)
+			pruned = true
+		}
+	}
+	return pruned
+}
+
+// FileStore keeps deliveries in a JSON file, which is rewritten atomically
+// on every change.
+type FileStore struct {
+	path string
+
+	mu        sync.Mutex
+	delivered map[string]time.Time
+}
+
+// NewFileStore opens the state file at path, starting empty if it does
+// not exist yet.
+func NewFileStore(path string) (*FileStore, error) {
+	fs := &FileStore{path: path, delivered: make(map[string]time.Time)}
+
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return fs, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read bridge state: %w", err)
+	}
+	if err := json.Unmarshal(data, &fs.delivered); err != nil {
+		return nil, fmt.Errorf("failed to decode bridge state: %w", err)
+	}
+	return fs, nil
+}
+
+func (fs *FileStore) Delivered(notificationID string) (bool, error) {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+	_, ok := fs.delivered[notificationID]
+	return ok, nil
+}
+
+func (fs *FileStore) SetDelivered(notificationID string, at time.Time) error {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+	fs.delivered[notificationID] = at
+	return fs.save()
+}
+
+func (fs *FileStore) Prune(before time.Time, keep map[string]bool) error {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+	if !prune(fs.delivered, before, keep) {
+		return nil
+	}
+	return fs.save()
+}
+
+func (fs *FileStore) save() error {
+	data, err := json.MarshalIndent(fs.delivered, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := shared.WriteFileAtomic(fs.path, data); err != nil {
+		return fmt.Errorf("failed to write bridge state: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,37 @@
+package bridge
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestFileStore(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "bridge.json")
+	now := time.Date(2025, 12, 6, 10, 0, 0, 0, time.UTC)
+
+	fs, err := NewFileStore(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	fs.SetDelivered("n1", now.Add(-Retention-time.Hour))
+	fs.SetDelivered("n2", now)
+	fs.SetDelivered("n3", now.Add(-Retention-time.Hour))
+	if err := fs.Prune(now.Add(-Retention), map[string]bool{"n3": true}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	reopened, err := NewFileStore(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if ok, _ := reopened.Delivered("n2"); !ok {
+		t.Error("expected n2 to be remembered")
+	}
+	if ok, _ := reopened.Delivered("n1"); ok {
+		t.Error("expected n1 to be forgotten after the retention period")
+	}
+	if ok, _ := reopened.Delivered("n3"); !ok {
+		t.Error("expected n3 to be remembered while it is still listed")
+	}
+}
@@ -0,0 +1,229 @@
+package fizzy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+const (
+	DefaultBulkConcurrency = 4
+	DefaultBulkRetries     = 3
+)
+
+// Bulk queues card operations and runs them concurrently. Operations on the
+// same card run one after another in the order they were queued; different
+// cards are processed in parallel, up to the configured concurrency.
+//
+//	report := client.Bulk().
+//		Close(stale...).
+//		Tag(stale, "stale").
+//		Run(ctx)
+//
+// Requests that fail because of rate limiting or server errors are retried,
+// waiting as long as the API's Retry-After header asks. Combine with
+// WithRateLimiter to stay under the API's limits in the first place.
+type Bulk struct {
+	client      *Client
+	concurrency int
+	retries     int
+	backoff     time.Duration
+	sleep       func(ctx context.Context, d time.Duration)
+	ops         []bulkOp
+}
+
+type bulkOp struct {
+	name       string
+	cardNumber int
+	fn         func(ctx context.Context, cardNumber int) error
+}
+
+// BulkResult is the outcome of one operation on one card.
+type BulkResult struct {
+	Operation  string
+	CardNumber int
+	Err        error
+
+	// Attempts is the number of requests made, including retries.
+	Attempts int
+}
+
+// Retried reports whether the operation needed more than one attempt.
+func (r BulkResult) Retried() bool {
+	return r.Attempts > 1
+}
+
+// BulkReport lists the result of every queued operation, in queue order.
+type BulkReport struct {
+	Results []BulkResult
+}
+
+// Failed returns the results of operations that did not succeed.
+func (r *BulkReport) Failed() []BulkResult {
+	var failed []BulkResult
+	for _, result := range r.Results {
+		if result.Err != nil {
+			failed = append(failed, result)
+		}
+	}
+	return failed
+}
+
+// Err joins the errors of failed operations, or returns nil if every
+// operation succeeded.
+func (r *BulkReport) Err() error {
+	var errs []error
+	for _, result := range r.Failed() {
+		errs = append(errs, fmt.Errorf("%s card %d: %w", result.Operation, result.CardNumber, result.Err))
+	}
+	return errors.Join(errs...)
+}
+
+// Bulk starts a batch of card operations.
+func (c *Client) Bulk() *Bulk {
+	return &Bulk{
+		client:      c,
+		concurrency: DefaultBulkConcurrency,
+		retries:     DefaultBulkRetries,
+		backoff:     500 * time.Millisecond,
+		sleep:       sleepContext,
+	}
+}
+
+// Concurrency sets how many cards are processed at once.
+func (b *Bulk) Concurrency(n int) *Bulk {
+	if n > 0 {
+		b.concurrency = n
+	}
+	return b
+}
+
+// Retries sets how many times a rate limited or failed request is retried.
+func (b *Bulk) Retries(n int) *Bulk {
+	if n >= 0 {
+		b.retries = n
+	}
+	return b
+}
+
+// Do queues a custom operation for each card, for anything without a
+// dedicated method. Failed operations are retried, so fn must be safe to
+// repeat: prefer EnsureTagged to TagCard, for instance.
+func (b *Bulk) Do(name string, cardNumbers []int, fn func(ctx context.Context, cardNumber int) error) *Bulk {
+	for _, number := range cardNumbers {
+		b.ops = append(b.ops, bulkOp{name: name, cardNumber: number, fn: fn})
+	}
+	return b
+}
+
+func (b *Bulk) Close(cardNumbers ...int) *Bulk {
+	return b.Do("close", cardNumbers, b.client.CloseCard)
+}
+
+func (b *Bulk) Reopen(cardNumbers ...int) *Bulk {
+	return b.Do("reopen", cardNumbers, b.client.ReopenCard)
+}
+
+func (b *Bulk) Postpone(cardNumbers ...int) *Bulk {
+	return b.Do("postpone", cardNumbers, b.client.PostponeCard)
+}
+
+func (b *Bulk) Delete(cardNumbers ...int) *Bulk {
+	return b.Do("delete", cardNumbers, b.client.DeleteCard)
+}
+
+// Tag adds tagTitle to each card that lacks it, like EnsureTagged, so a
+// retried request cannot toggle the tag off again.
+func (b *Bulk) Tag(cardNumbers []int, tagTitle string) *Bulk {
+	return b.Do("tag", cardNumbers, func(ctx context.Context, cardNumber int) error {
+		return b.client.EnsureTagged(ctx, cardNumber, tagTitle)
+	})
+}
+
+// Assign assigns userID to each card they are not assigned to yet, like
+// EnsureAssigned.
+func (b *Bulk) Assign(cardNumbers []int, userID string) *Bulk {
+	return b.Do("assign", cardNumbers, func(ctx context.Context, cardNumber int) error {
+		return b.client.EnsureAssigned(ctx, cardNumber, userID)
+	})
+}
+
+func (b *Bulk) Triage(cardNumbers []int, columnID string) *Bulk {
+	return b.Do("triage", cardNumbers, func(ctx context.Context, cardNumber int) error {
+		return b.client.TriageCard(ctx, cardNumber, columnID)
+	})
+}
+
+// Run executes the queued operations and reports on each. It does not stop
+// at the first failure; operations not started before ctx is cancelled fail
+// with the context's error.
+func (b *Bulk) Run(ctx context.Context) *BulkReport {
+	report := &BulkReport{Results: make([]BulkResult, len(b.ops))}
+
+	var cards []int
+	byCard := make(map[int][]int)
+	for i, op := range b.ops {
+		if _, seen := byCard[op.cardNumber]; !seen {
+			cards = append(cards, op.cardNumber)
+		}
+		byCard[op.cardNumber] = append(byCard[op.cardNumber], i)
+	}
+
+	queue := make(chan int)
+	var wg sync.WaitGroup
+	for range min(b.concurrency, len(cards)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for number := range queue {
+				for _, i := range byCard[number] {
+					report.Results[i] = b.run(ctx, b.ops[i])
+				}
+			}
+		}()
+	}
+	for _, number := range cards {
+		queue <- number
+	}
+	close(queue)
+	wg.Wait()
+
+	return report
+}
+
+func (b *Bulk) run(ctx context.Context, op bulkOp) BulkResult {
+	result := BulkResult{Operation: op.name, CardNumber: op.cardNumber}
+
+	for {
+		if err := ctx.Err(); err != nil {
+			result.Err = err
+			return result
+		}
+
+		result.Attempts++
+		result.Err = op.fn(ctx, op.cardNumber)
+
+		var apiErr *APIError
+		if result.Err == nil || !errors.As(result.Err, &apiErr) || !apiErr.Temporary() || result.Attempts > b.retries {
+			return result
+		}
+
+		delay := apiErr.RetryAfter
+		if delay == 0 {
+			delay = b.backoff << (result.Attempts - 1)
+		}
+		b.sleep(ctx, delay)
+	}
+}
+
+// sleepContext waits for d or until ctx is done.
+func sleepContext(ctx context.Context, d time.Duration) {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+	case <-timer.C:
+	}
+}
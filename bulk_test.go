@@ -0,0 +1,175 @@
+package fizzy
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestBulk(t *testing.T) {
+	t.Run("runs operations and reports per item", func(t *testing.T) {
+		var mu sync.Mutex
+		var calls []string
+		var inFlight, maxInFlight int32
+		rateLimited := false
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			n := atomic.AddInt32(&inFlight, 1)
+			defer atomic.AddInt32(&inFlight, -1)
+			for {
+				m := atomic.LoadInt32(&maxInFlight)
+				if n <= m || atomic.CompareAndSwapInt32(&maxInFlight, m, n) {
+					break
+				}
+			}
+			time.Sleep(5 * time.Millisecond)
+
+			mu.Lock()
+			defer mu.Unlock()
+			path := strings.TrimPrefix(r.URL.Path, "/test-account")
+			calls = append(calls, r.Method+" "+path)
+
+			switch {
+			case r.Method == http.MethodGet:
+				w.Header().Set("Content-Type", "application/json")
+				w.Write([]byte(`{"number": 1, "tags": []}`))
+			case path == "/cards/2/closure" && !rateLimited:
+				rateLimited = true
+				w.WriteHeader(http.StatusTooManyRequests)
+			case strings.HasPrefix(path, "/cards/3/"):
+				w.WriteHeader(http.StatusNotFound)
+				w.Write([]byte("not found"))
+			default:
+				w.WriteHeader(http.StatusNoContent)
+			}
+		}))
+		defer server.Close()
+
+		client, _ := NewClient("/test-account", "test-token", WithBaseURL(server.URL))
+		bulk := client.Bulk().Concurrency(2)
+		bulk.backoff = time.Millisecond
+		report := bulk.Close(1, 2, 3, 4).Tag([]int{1, 2}, "stale").Run(context.Background())
+
+		if len(report.Results) != 6 {
+			t.Fatalf("expected 6 results, got %d", len(report.Results))
+		}
+		if r := report.Results[1]; r.CardNumber != 2 || r.Err != nil || !r.Retried() {
+			t.Errorf("expected close of card 2 to succeed after a retry, got %+v", r)
+		}
+		if r := report.Results[4]; r.Operation != "tag" || r.CardNumber != 1 || r.Err != nil {
+			t.Errorf("unexpected result: %+v", r)
+		}
+
+		failed := report.Failed()
+		if len(failed) != 1 || failed[0].CardNumber != 3 || failed[0].Retried() {
+			t.Errorf("expected only card 3 to fail without retries, got %+v", failed)
+		}
+		if err := report.Err(); err == nil || !strings.Contains(err.Error(), "close card 3") {
+			t.Errorf("unexpected error: %v", err)
+		}
+
+		if maxInFlight > 2 {
+			t.Errorf("expected at most 2 concurrent requests, got %d", maxInFlight)
+		}
+
+		closeAt, tagAt := -1, -1
+		for i, call := range calls {
+			switch call {
+			case "POST /cards/1/closure":
+				closeAt = i
+			case "POST /cards/1/taggings":
+				tagAt = i
+			}
+		}
+		if closeAt < 0 || tagAt < closeAt {
+			t.Errorf("expected card 1 to be closed before it was tagged, got %v", calls)
+		}
+	})
+
+	t.Run("honors Retry-After and gives up after retries", func(t *testing.T) {
+		var attempts int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&attempts, 1)
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		defer server.Close()
+
+		client, _ := NewClient("/test-account", "test-token", WithBaseURL(server.URL))
+		bulk := client.Bulk().Retries(1)
+		var waits []time.Duration
+		bulk.sleep = func(ctx context.Context, d time.Duration) { waits = append(waits, d) }
+		report := bulk.Close(1).Run(context.Background())
+
+		if len(waits) != 1 || waits[0] != time.Second {
+			t.Errorf("expected to wait for Retry-After once, waited %v", waits)
+		}
+		if attempts != 2 || report.Results[0].Attempts != 2 || report.Results[0].Err == nil {
+			t.Errorf("expected 2 failed attempts, got %d: %+v", attempts, report.Results[0])
+		}
+	})
+
+	t.Run("does not toggle a tag off when retrying", func(t *testing.T) {
+		var mu sync.Mutex
+		tagged := false
+		failed := false
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			mu.Lock()
+			defer mu.Unlock()
+			if r.Method == http.MethodGet {
+				tags := "[]"
+				if tagged {
+					tags = `["stale"]`
+				}
+				w.Header().Set("Content-Type", "application/json")
+				w.Write([]byte(`{"number": 1, "tags": ` + tags + `}`))
+				return
+			}
+			tagged = !tagged
+			if !failed {
+				// The tag is applied, but the response is lost.
+				failed = true
+				w.WriteHeader(http.StatusBadGateway)
+				return
+			}
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		defer server.Close()
+
+		client, _ := NewClient("/test-account", "test-token", WithBaseURL(server.URL))
+		bulk := client.Bulk()
+		bulk.sleep = func(context.Context, time.Duration) {}
+		report := bulk.Tag([]int{1}, "stale").Run(context.Background())
+
+		if r := report.Results[0]; r.Err != nil || !r.Retried() {
+			t.Errorf("expected tag to succeed after a retry, got %+v", r)
+		}
+		if !tagged {
+			t.Error("expected card to stay tagged")
+		}
+	})
+
+	t.Run("fails remaining operations when context is cancelled", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		defer server.Close()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		client, _ := NewClient("/test-account", "test-token", WithBaseURL(server.URL))
+		report := client.Bulk().Close(1, 2).Run(ctx)
+
+		for _, r := range report.Results {
+			if r.Err != context.Canceled || r.Attempts != 0 {
+				t.Errorf("expected cancelled result, got %+v", r)
+			}
+		}
+	})
+}
@@ -4,13 +4,62 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"iter"
 	"net/http"
+	"strconv"
+
+	"github.com/rogeriopvl/fizzy-go/internal/shared"
 )
 
 // ErrNoBoardSelected is returned when an operation requires a board but none is set.
 var ErrNoBoardSelected = errors.New("no board selected: use SetBoard or WithBoard when creating the client")
 
 func (c *Client) GetCards(ctx context.Context, filters CardFilters) ([]Card, error) {
+	req, err := c.newCardsRequest(ctx, filters)
+	if err != nil {
+		return nil, err
+	}
+
+	return getAllPages[Card](c, req)
+}
+
+// Cards iterates over the cards matching filters, fetching pages as they
+// are needed rather than all up front. Iteration stops at the first error,
+// which is yielded with a zero Card.
+func (c *Client) Cards(ctx context.Context, filters CardFilters) iter.Seq2[Card, error] {
+	return func(yield func(Card, error) bool) {
+		req, err := c.newCardsRequest(ctx, filters)
+		if err != nil {
+			yield(Card{}, err)
+			return
+		}
+
+		for {
+			var page []Card
+			header, _, err := c.doRequest(req, &page)
+			if err != nil {
+				yield(Card{}, err)
+				return
+			}
+			for _, card := range page {
+				if !yield(card, nil) {
+					return
+				}
+			}
+
+			req, err = c.nextPageRequest(req, header)
+			if err != nil {
+				yield(Card{}, err)
+				return
+			}
+			if req == nil {
+				return
+			}
+		}
+	}
+}
+
+func (c *Client) newCardsRequest(ctx context.Context, filters CardFilters) (*http.Request, error) {
 	endpointURL := c.AccountBaseURL + "/cards"
 
 	req, err := c.newRequest(ctx, http.MethodGet, endpointURL, nil)
@@ -59,13 +108,7 @@ func (c *Client) GetCards(ctx context.Context, filters CardFilters) ([]Card, err
 
 	req.URL.RawQuery = q.Encode()
 
-	var response []Card
-	_, err = c.decodeResponse(req, &response)
-	if err != nil {
-		return nil, err
-	}
-
-	return response, nil
+	return req, nil
 }
 
 func (c *Client) GetCard(ctx context.Context, cardNumber int) (*Card, error) {
@@ -103,6 +146,35 @@ func (c *Client) CreateCard(ctx context.Context, payload CreateCardPayload) erro
 	return err
 }
 
+// CreateCardAndGetNumber creates a card on the selected board and returns its
+// number, read from the Location header of the response.
+func (c *Client) CreateCardAndGetNumber(ctx context.Context, payload CreateCardPayload) (int, error) {
+	if c.BoardBaseURL == "" {
+		return 0, ErrNoBoardSelected
+	}
+
+	endpointURL := c.BoardBaseURL + "/cards"
+
+	body := map[string]CreateCardPayload{"card": payload}
+
+	req, err := c.newRequest(ctx, http.MethodPost, endpointURL, body)
+	if err != nil {
+		return 0, fmt.Errorf("failed to create card request: %w", err)
+	}
+
+	id, err := c.createResource(req)
+	if err != nil {
+		return 0, err
+	}
+
+	number, err := strconv.Atoi(id)
+	if err != nil {
+		return 0, fmt.Errorf("unexpected card number %q in Location header", id)
+	}
+
+	return number, nil
+}
+
 func (c *Client) UpdateCard(ctx context.Context, cardNumber int, payload UpdateCardPayload) (*Card, error) {
 	endpointURL := fmt.Sprintf("%s/cards/%d", c.AccountBaseURL, cardNumber)
 
@@ -289,3 +361,132 @@ func (c *Client) TagCard(ctx context.Context, cardNumber int, tagTitle string) e
 	_, err = c.decodeResponse(req, nil, http.StatusNoContent)
 	return err
 }
+
+// EnsureAssigned assigns userID to the card unless already assigned. Unlike
+// AssignCard it is safe to retry.
+func (c *Client) EnsureAssigned(ctx context.Context, cardNumber int, userID string) error {
+	return c.setAssigned(ctx, cardNumber, userID, true)
+}
+
+// EnsureUnassigned removes userID from the card's assignees if present.
+func (c *Client) EnsureUnassigned(ctx context.Context, cardNumber int, userID string) error {
+	return c.setAssigned(ctx, cardNumber, userID, false)
+}
+
+func (c *Client) setAssigned(ctx context.Context, cardNumber int, userID string, assigned bool) error {
+	card, err := c.GetCard(ctx, cardNumber)
+	if err != nil {
+		return err
+	}
+	if card.hasAssignee(userID) == assigned {
+		return nil
+	}
+	return c.AssignCard(ctx, cardNumber, userID)
+}
+
+// SetAssignees makes userIDs the card's exact set of assignees, toggling only
+// the assignments that differ.
+func (c *Client) SetAssignees(ctx context.Context, cardNumber int, userIDs []string) error {
+	card, err := c.GetCard(ctx, cardNumber)
+	if err != nil {
+		return err
+	}
+
+	wanted := make(map[string]bool, len(userIDs))
+	for _, id := range userIDs {
+		wanted[id] = true
+	}
+	for _, assignee := range card.Assignees {
+		if !wanted[assignee.ID] {
+			if err := c.AssignCard(ctx, cardNumber, assignee.ID); err != nil {
+				return err
+			}
+		}
+	}
+	added := make(map[string]bool, len(userIDs))
+	for _, id := range userIDs {
+		if added[id] || card.hasAssignee(id) {
+			continue
+		}
+		added[id] = true
+		if err := c.AssignCard(ctx, cardNumber, id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// EnsureTagged adds tagTitle to the card unless already present. Unlike
+// TagCard it is safe to retry.
+func (c *Client) EnsureTagged(ctx context.Context, cardNumber int, tagTitle string) error {
+	return c.setTagged(ctx, cardNumber, tagTitle, true)
+}
+
+// EnsureUntagged removes tagTitle from the card if present.
+func (c *Client) EnsureUntagged(ctx context.Context, cardNumber int, tagTitle string) error {
+	return c.setTagged(ctx, cardNumber, tagTitle, false)
+}
+
+func (c *Client) setTagged(ctx context.Context, cardNumber int, tagTitle string, tagged bool) error {
+	card, err := c.GetCard(ctx, cardNumber)
+	if err != nil {
+		return err
+	}
+	if card.hasTag(tagTitle) == tagged {
+		return nil
+	}
+	return c.TagCard(ctx, cardNumber, tagTitle)
+}
+
+// SetTags makes tagTitles the card's exact set of tags, toggling only the
+// tags that differ. Titles are compared case-insensitively, ignoring a
+// leading "#".
+func (c *Client) SetTags(ctx context.Context, cardNumber int, tagTitles []string) error {
+	card, err := c.GetCard(ctx, cardNumber)
+	if err != nil {
+		return err
+	}
+
+	wanted := make(map[string]bool, len(tagTitles))
+	for _, title := range tagTitles {
+		wanted[shared.TagKey(title)] = true
+	}
+	for _, title := range card.Tags {
+		if !wanted[shared.TagKey(title)] {
+			if err := c.TagCard(ctx, cardNumber, title); err != nil {
+				return err
+			}
+		}
+	}
+	added := make(map[string]bool, len(tagTitles))
+	for _, title := range tagTitles {
+		key := shared.TagKey(title)
+		if added[key] || card.hasTag(title) {
+			continue
+		}
+		added[key] = true
+		if err := c.TagCard(ctx, cardNumber, title); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (card *Card) hasAssignee(userID string) bool {
+	for _, assignee := range card.Assignees {
+		if assignee.ID == userID {
+			return true
+		}
+	}
+	return false
+}
+
+func (card *Card) hasTag(tagTitle string) bool {
+	key := shared.TagKey(tagTitle)
+	for _, title := range card.Tags {
+		if shared.TagKey(title) == key {
+			return true
+		}
+	}
+	return false
+}
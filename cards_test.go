@@ -4,8 +4,10 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 )
 
@@ -78,6 +80,36 @@ func TestGetCards(t *testing.T) {
 	})
 }
 
+func TestCards(t *testing.T) {
+	pages := 0
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		pages++
+		if r.URL.Query().Get("indexed_by") != "closed" {
+			t.Errorf("expected filters to be sent, got %s", r.URL.RawQuery)
+		}
+		w.Header().Set("Link", fmt.Sprintf(`<%s/test-account/cards?indexed_by=closed&page=%d>; rel="next"`, server.URL, pages+1))
+		json.NewEncoder(w).Encode([]Card{{Number: pages*2 - 1}, {Number: pages * 2}})
+	}))
+	defer server.Close()
+
+	client, _ := NewClient("/test-account", "test-token", WithBaseURL(server.URL))
+	var numbers []int
+	for card, err := range client.Cards(context.Background(), CardFilters{IndexedBy: "closed"}) {
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		numbers = append(numbers, card.Number)
+		if len(numbers) == 3 {
+			break
+		}
+	}
+
+	if fmt.Sprint(numbers) != "[1 2 3]" || pages != 2 {
+		t.Errorf("expected 3 cards from 2 pages, got %v from %d", numbers, pages)
+	}
+}
+
 func TestGetCard(t *testing.T) {
 	t.Run("returns card on success", func(t *testing.T) {
 		card := Card{ID: "card-1", Number: 42, Title: "Test Card"}
@@ -145,6 +177,39 @@ func TestCreateCard(t *testing.T) {
 	})
 }
 
+func TestCreateCardAndGetNumber(t *testing.T) {
+	t.Run("returns number from Location header", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/test-account/boards/board-1/cards" {
+				t.Errorf("unexpected path: %s", r.URL.Path)
+			}
+
+			w.Header().Set("Location", "/test-account/cards/128")
+			w.WriteHeader(http.StatusCreated)
+		}))
+		defer server.Close()
+
+		client, _ := NewClient("/test-account", "test-token", WithBaseURL(server.URL), WithBoard("board-1"))
+		number, err := client.CreateCardAndGetNumber(context.Background(), CreateCardPayload{Title: "New Card"})
+
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if number != 128 {
+			t.Errorf("expected card number 128, got %d", number)
+		}
+	})
+
+	t.Run("returns error when no board selected", func(t *testing.T) {
+		client, _ := NewClient("/test-account", "test-token")
+		_, err := client.CreateCardAndGetNumber(context.Background(), CreateCardPayload{Title: "New Card"})
+
+		if !errors.Is(err, ErrNoBoardSelected) {
+			t.Errorf("expected ErrNoBoardSelected, got %v", err)
+		}
+	})
+}
+
 func TestUpdateCard(t *testing.T) {
 	t.Run("updates card on success", func(t *testing.T) {
 		card := Card{ID: "card-1", Number: 42, Title: "Updated Card"}
@@ -352,3 +417,169 @@ func TestTagCard(t *testing.T) {
 		}
 	})
 }
+
+// newToggleServer serves card 42 with the given assignees and tags and
+// records the toggles posted to it.
+func newToggleServer(t *testing.T, assignees []string, tags []string, toggles *[]string) *httptest.Server {
+	t.Helper()
+
+	var users []User
+	for _, id := range assignees {
+		users = append(users, User{ID: id})
+	}
+	card := map[string]any{"number": 42, "tags": tags, "assignees": users}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(card)
+			return
+		}
+
+		var body map[string]string
+		json.NewDecoder(r.Body).Decode(&body)
+		switch r.URL.Path {
+		case "/test-account/cards/42/assignments":
+			*toggles = append(*toggles, "assign "+body["assignee_id"])
+		case "/test-account/cards/42/taggings":
+			*toggles = append(*toggles, "tag "+body["tag_title"])
+		default:
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestEnsureAssigned(t *testing.T) {
+	var toggles []string
+	server := newToggleServer(t, []string{"user-1"}, nil, &toggles)
+	client, _ := NewClient("/test-account", "test-token", WithBaseURL(server.URL))
+	ctx := context.Background()
+
+	for _, err := range []error{
+		client.EnsureAssigned(ctx, 42, "user-1"),
+		client.EnsureAssigned(ctx, 42, "user-2"),
+		client.EnsureUnassigned(ctx, 42, "user-1"),
+		client.EnsureUnassigned(ctx, 42, "user-3"),
+	} {
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	if strings.Join(toggles, ",") != "assign user-2,assign user-1" {
+		t.Errorf("unexpected toggles: %v", toggles)
+	}
+}
+
+func TestEnsureTagged(t *testing.T) {
+	var toggles []string
+	server := newToggleServer(t, nil, []string{"bug"}, &toggles)
+	client, _ := NewClient("/test-account", "test-token", WithBaseURL(server.URL))
+	ctx := context.Background()
+
+	for _, err := range []error{
+		client.EnsureTagged(ctx, 42, "#Bug"),
+		client.EnsureTagged(ctx, 42, "stale"),
+		client.EnsureUntagged(ctx, 42, "bug"),
+		client.EnsureUntagged(ctx, 42, "urgent"),
+	} {
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	if strings.Join(toggles, ",") != "tag stale,tag bug" {
+		t.Errorf("unexpected toggles: %v", toggles)
+	}
+}
+
+func TestSetAssignees(t *testing.T) {
+	var toggles []string
+	server := newToggleServer(t, []string{"user-1", "user-2"}, nil, &toggles)
+	client, _ := NewClient("/test-account", "test-token", WithBaseURL(server.URL))
+
+	err := client.SetAssignees(context.Background(), 42, []string{"user-2", "user-3", "user-3"})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if strings.Join(toggles, ",") != "assign user-1,assign user-3" {
+		t.Errorf("unexpected toggles: %v", toggles)
+	}
+}
+
+func TestSetTags(t *testing.T) {
+	var toggles []string
+	server := newToggleServer(t, nil, []string{"bug", "stale"}, &toggles)
+	client, _ := NewClient("/test-account", "test-token", WithBaseURL(server.URL))
+
+	err := client.SetTags(context.Background(), 42, []string{"Bug", "urgent", "#urgent"})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if strings.Join(toggles, ",") != "tag stale,tag urgent" {
+		t.Errorf("unexpected toggles: %v", toggles)
+	}
+}
+
+func TestCardUnmarshalJSON(t *testing.T) {
+	t.Run("decodes tag titles", func(t *testing.T) {
+		var card Card
+		if err := json.Unmarshal([]byte(`{"number": 1, "tags": ["bug", "ui"]}`), &card); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(card.Tags) != 2 || card.Tags[1] != "ui" || card.TagDetails != nil {
+			t.Errorf("unexpected tags: %v %v", card.Tags, card.TagDetails)
+		}
+	})
+
+	t.Run("decodes tag objects and richer fields", func(t *testing.T) {
+		var card Card
+		err := json.Unmarshal([]byte(`{
+			"number": 1,
+			"tags": [{"id": "tag-1", "title": "bug"}],
+			"assignees": [{"id": "user-1"}],
+			"watchers": [{"id": "user-2"}],
+			"closer": {"id": "user-3"},
+			"closed_at": "2025-12-05T19:38:48.553Z",
+			"position": 3,
+			"comments_count": 7
+		}`), &card)
+
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(card.Tags) != 1 || card.Tags[0] != "bug" {
+			t.Errorf("unexpected tags: %v", card.Tags)
+		}
+		if len(card.TagDetails) != 1 || card.TagDetails[0].ID != "tag-1" {
+			t.Errorf("unexpected tag details: %v", card.TagDetails)
+		}
+		if card.Number != 1 || len(card.Assignees) != 1 || len(card.Watchers) != 1 {
+			t.Errorf("unexpected card: %+v", card)
+		}
+		if card.Closer == nil || card.Closer.ID != "user-3" || card.ClosedAt == "" {
+			t.Errorf("unexpected closure: %+v %s", card.Closer, card.ClosedAt)
+		}
+		if card.Position != 3 || card.CommentsCount != 7 {
+			t.Errorf("unexpected position %d or comments count %d", card.Position, card.CommentsCount)
+		}
+	})
+
+	t.Run("round trips", func(t *testing.T) {
+		original := Card{Number: 1, Tags: []string{"bug"}, TagDetails: []Tag{{ID: "tag-1", Title: "bug"}}}
+		data, _ := json.Marshal(original)
+
+		var card Card
+		if err := json.Unmarshal(data, &card); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(card.TagDetails) != 1 || card.TagDetails[0].ID != "tag-1" || card.Tags[0] != "bug" {
+			t.Errorf("unexpected card: %+v", card)
+		}
+	})
+}
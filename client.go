@@ -5,9 +5,16 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"mime/multipart"
 	"net/http"
+	"net/url"
+	"path"
+	"regexp"
+	"strconv"
+	"strings"
 	"time"
 )
 
@@ -16,6 +23,9 @@ const (
 	DefaultTimeout = 30 * time.Second
 )
 
+// ErrNotSupported is returned for operations the Fizzy API does not offer.
+var ErrNotSupported = errors.New("not supported by the Fizzy API")
+
 type Client struct {
 	BaseURL        string
 	AccountBaseURL string
@@ -23,6 +33,32 @@ type Client struct {
 	AccessToken    string
 	HTTPClient     *http.Client
 	boardID        string
+	limiter        Limiter
+}
+
+// Limiter throttles requests. Wait blocks until a request may be made.
+// *rate.Limiter from golang.org/x/time/rate satisfies it.
+type Limiter interface {
+	Wait(ctx context.Context) error
+}
+
+// APIError is returned when the API responds with an unexpected status code.
+type APIError struct {
+	StatusCode int
+	Body       string
+
+	// RetryAfter is the delay requested by the Retry-After header, if any.
+	RetryAfter time.Duration
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("unexpected status code %d: %s", e.StatusCode, e.Body)
+}
+
+// Temporary reports whether the request may succeed if retried: the API was
+// rate limiting or failed with a server error.
+func (e *APIError) Temporary() bool {
+	return e.StatusCode == http.StatusTooManyRequests || e.StatusCode >= 500
 }
 
 type ClientOption func(*Client)
@@ -39,6 +75,14 @@ func WithHTTPClient(httpClient *http.Client) ClientOption {
 	}
 }
 
+// WithRateLimiter makes every request wait on l first. Clients derived with
+// ForBoard share the limiter.
+func WithRateLimiter(l Limiter) ClientOption {
+	return func(c *Client) {
+		c.limiter = l
+	}
+}
+
 // WithBaseURL overrides the default API base URL (https://app.fizzy.do).
 func WithBaseURL(baseURL string) ClientOption {
 	return func(c *Client) {
@@ -83,6 +127,15 @@ func (c *Client) SetBoard(boardID string) {
 	}
 }
 
+// ForBoard returns a copy of the client scoped to boardID, leaving the
+// receiver's board selection untouched. It is useful when walking several
+// boards with board-specific operations such as GetColumns.
+func (c *Client) ForBoard(boardID string) *Client {
+	scoped := *c
+	scoped.SetBoard(boardID)
+	return &scoped
+}
+
 func (c *Client) newRequest(ctx context.Context, method, url string, body any) (*http.Request, error) {
 	var bodyReader io.Reader
 	if body != nil {
@@ -105,31 +158,180 @@ func (c *Client) newRequest(ctx context.Context, method, url string, body any) (
 	return req, nil
 }
 
+// newMultipartRequest builds a multipart/form-data request uploading the
+// contents of r as the form field field, for endpoints that accept files.
+func (c *Client) newMultipartRequest(ctx context.Context, method, url, field, filename string, r io.Reader) (*http.Request, error) {
+	var body bytes.Buffer
+	form := multipart.NewWriter(&body)
+
+	part, err := form.CreateFormFile(field, filename)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := io.Copy(part, r); err != nil {
+		return nil, fmt.Errorf("failed to read upload: %w", err)
+	}
+	if err := form.Close(); err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, url, &body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.AccessToken))
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Content-Type", form.FormDataContentType())
+
+	return req, nil
+}
+
 func (c *Client) decodeResponse(req *http.Request, v any, expectedStatus ...int) (int, error) {
+	_, status, err := c.doRequest(req, v, expectedStatus...)
+	return status, err
+}
+
+// doRequest performs req and decodes the body into v, returning the response
+// headers so callers can inspect Link or Location.
+func (c *Client) doRequest(req *http.Request, v any, expectedStatus ...int) (http.Header, int, error) {
 	expectedCode := http.StatusOK
 	if len(expectedStatus) > 0 {
 		expectedCode = expectedStatus[0]
 	}
 
+	if c.limiter != nil {
+		if err := c.limiter.Wait(req.Context()); err != nil {
+			return nil, 0, err
+		}
+	}
+
 	res, err := c.HTTPClient.Do(req)
 	if err != nil {
-		return 0, fmt.Errorf("failed to make request: %w", err)
+		return nil, 0, fmt.Errorf("failed to make request: %w", err)
 	}
 	defer res.Body.Close()
 
 	if res.StatusCode != expectedCode {
 		body, err := io.ReadAll(res.Body)
 		if err != nil {
-			return 0, fmt.Errorf("unexpected status code %d (failed to read error response: %w)", res.StatusCode, err)
+			return nil, 0, fmt.Errorf("unexpected status code %d (failed to read error response: %w)", res.StatusCode, err)
+		}
+		return nil, 0, &APIError{
+			StatusCode: res.StatusCode,
+			Body:       string(body),
+			RetryAfter: parseRetryAfter(res.Header.Get("Retry-After")),
 		}
-		return 0, fmt.Errorf("unexpected status code %d: %s", res.StatusCode, string(body))
 	}
 
 	if v != nil {
 		if err := json.NewDecoder(res.Body).Decode(v); err != nil {
-			return 0, fmt.Errorf("failed to decode response: %w", err)
+			return nil, 0, fmt.Errorf("failed to decode response: %w", err)
 		}
 	}
 
-	return res.StatusCode, nil
+	return res.Header, res.StatusCode, nil
+}
+
+// parseRetryAfter reads a Retry-After header given either in seconds or as
+// an HTTP date.
+func parseRetryAfter(value string) time.Duration {
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds > 0 {
+		return time.Duration(seconds) * time.Second
+	}
+	if t, err := http.ParseTime(value); err == nil {
+		if d := time.Until(t); d > 0 {
+			return d
+		}
+	}
+	return 0
+}
+
+// createResource performs a create request and returns the ID of the new
+// resource, taken from the last segment of the Location header the API
+// responds with.
+func (c *Client) createResource(req *http.Request) (string, error) {
+	header, _, err := c.doRequest(req, nil, http.StatusCreated)
+	if err != nil {
+		return "", err
+	}
+	return idFromLocation(header.Get("Location"))
+}
+
+func idFromLocation(location string) (string, error) {
+	if location == "" {
+		return "", fmt.Errorf("response has no Location header")
+	}
+	if i := strings.IndexAny(location, "?#"); i >= 0 {
+		location = location[:i]
+	}
+	id := strings.TrimSuffix(path.Base(location), ".json")
+	if id == "" || id == "/" || id == "." {
+		return "", fmt.Errorf("unexpected Location header %q", location)
+	}
+	return id, nil
+}
+
+var nextLinkPattern = regexp.MustCompile(`<([^>]+)>;\s*rel="next"`)
+
+// nextPageURL extracts the rel="next" URL from a Link header, if any.
+func nextPageURL(header http.Header) string {
+	for _, link := range header.Values("Link") {
+		if m := nextLinkPattern.FindStringSubmatch(link); m != nil {
+			return m[1]
+		}
+	}
+	return ""
+}
+
+// nextPageRequest builds the request for the rel="next" URL in the header
+// of the response to req, or returns nil on the last page. Next URLs on
+// another host than BaseURL are refused, so the token never leaves the API.
+func (c *Client) nextPageRequest(req *http.Request, header http.Header) (*http.Request, error) {
+	next := nextPageURL(header)
+	if next == "" {
+		return nil, nil
+	}
+
+	u, err := req.URL.Parse(next)
+	if err != nil {
+		return nil, fmt.Errorf("invalid next page URL %q: %w", next, err)
+	}
+	base, err := url.Parse(c.BaseURL)
+	if err != nil || u.Scheme != base.Scheme || u.Host != base.Host {
+		return nil, fmt.Errorf("refusing to follow next page URL on another host: %s", u.Redacted())
+	}
+
+	nextReq, err := c.newRequest(req.Context(), http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create next page request: %w", err)
+	}
+	return nextReq, nil
+}
+
+// getAllPages performs req and follows the Link header's rel="next" URL until
+// every page of a list endpoint has been collected.
+func getAllPages[T any](c *Client, req *http.Request) ([]T, error) {
+	// Callers encoding the result get [] rather than null when it is empty.
+	all := []T{}
+
+	for {
+		var page []T
+		header, _, err := c.doRequest(req, &page)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, page...)
+
+		req, err = c.nextPageRequest(req, header)
+		if err != nil {
+			return nil, err
+		}
+		if req == nil {
+			return all, nil
+		}
+	}
 }
@@ -1,7 +1,12 @@
 package fizzy
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
 	"net/http"
+	"net/http/httptest"
 	"testing"
 	"time"
 )
@@ -116,3 +121,140 @@ func TestSetBoard(t *testing.T) {
 		}
 	})
 }
+
+func TestForBoard(t *testing.T) {
+	client, _ := NewClient("/test-account", "test-token", WithBoard("board-1"))
+
+	scoped := client.ForBoard("board-2")
+
+	expectedBoardURL := DefaultBaseURL + "/test-account/boards/board-2"
+	if scoped.BoardBaseURL != expectedBoardURL {
+		t.Errorf("expected BoardBaseURL '%s', got '%s'", expectedBoardURL, scoped.BoardBaseURL)
+	}
+	if client.BoardBaseURL != DefaultBaseURL+"/test-account/boards/board-1" {
+		t.Errorf("expected original client to keep its board, got '%s'", client.BoardBaseURL)
+	}
+}
+
+func TestPagination(t *testing.T) {
+	t.Run("follows Link rel=next across pages", func(t *testing.T) {
+		var server *httptest.Server
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+
+			switch r.URL.Query().Get("page") {
+			case "":
+				w.Header().Set("Link", fmt.Sprintf(`<%s/test-account/tags?page=2>; rel="next"`, server.URL))
+				json.NewEncoder(w).Encode([]Tag{{ID: "tag-1"}})
+			case "2":
+				json.NewEncoder(w).Encode([]Tag{{ID: "tag-2"}})
+			default:
+				t.Errorf("unexpected page: %s", r.URL.Query().Get("page"))
+			}
+		}))
+		defer server.Close()
+
+		client, _ := NewClient("/test-account", "test-token", WithBaseURL(server.URL))
+		result, err := client.GetTags(context.Background())
+
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(result) != 2 || result[1].ID != "tag-2" {
+			t.Errorf("expected tags from both pages, got %v", result)
+		}
+	})
+
+	t.Run("follows relative next URLs", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Query().Get("page") == "" {
+				w.Header().Set("Link", `</test-account/tags?page=2>; rel="next"`)
+				json.NewEncoder(w).Encode([]Tag{{ID: "tag-1"}})
+				return
+			}
+			json.NewEncoder(w).Encode([]Tag{{ID: "tag-2"}})
+		}))
+		defer server.Close()
+
+		client, _ := NewClient("/test-account", "test-token", WithBaseURL(server.URL))
+		result, err := client.GetTags(context.Background())
+
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(result) != 2 {
+			t.Errorf("expected tags from both pages, got %v", result)
+		}
+	})
+
+	t.Run("returns an empty slice when there are no results", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			fmt.Fprint(w, "[]")
+		}))
+		defer server.Close()
+
+		client, _ := NewClient("/test-account", "test-token", WithBaseURL(server.URL))
+		result, err := client.GetTags(context.Background())
+
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if data, _ := json.Marshal(result); string(data) != "[]" {
+			t.Errorf("expected an empty slice, got %s", data)
+		}
+	})
+
+	t.Run("refuses next URLs on another host", func(t *testing.T) {
+		other := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			t.Errorf("token sent to another host: %s", r.Header.Get("Authorization"))
+		}))
+		defer other.Close()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Link", fmt.Sprintf(`<%s/test-account/tags?page=2>; rel="next"`, other.URL))
+			json.NewEncoder(w).Encode([]Tag{{ID: "tag-1"}})
+		}))
+		defer server.Close()
+
+		client, _ := NewClient("/test-account", "test-token", WithBaseURL(server.URL))
+		if _, err := client.GetTags(context.Background()); err == nil {
+			t.Error("expected error, got nil")
+		}
+	})
+}
+
+type countingLimiter struct {
+	waits int
+}
+
+func (l *countingLimiter) Wait(ctx context.Context) error {
+	l.waits++
+	return nil
+}
+
+func TestAPIError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "30")
+		w.WriteHeader(http.StatusTooManyRequests)
+		w.Write([]byte("slow down"))
+	}))
+	defer server.Close()
+
+	limiter := &countingLimiter{}
+	client, _ := NewClient("/test-account", "test-token", WithBaseURL(server.URL), WithRateLimiter(limiter))
+	err := client.ForBoard("board-1").CloseCard(context.Background(), 1)
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("expected *APIError, got %v", err)
+	}
+	if err.Error() != "unexpected status code 429: slow down" {
+		t.Errorf("unexpected message: %s", err)
+	}
+	if !apiErr.Temporary() || apiErr.RetryAfter != 30*time.Second {
+		t.Errorf("unexpected error: %+v", apiErr)
+	}
+	if limiter.waits != 1 {
+		t.Errorf("expected the limiter to be consulted once, got %d", limiter.waits)
+	}
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"slices"
+	"sort"
 )
 
 func (c *Client) GetColumns(ctx context.Context) ([]Column, error) {
@@ -18,13 +20,20 @@ func (c *Client) GetColumns(ctx context.Context) ([]Column, error) {
 		return nil, fmt.Errorf("failed to create get columns request: %w", err)
 	}
 
-	var response []Column
-	_, err = c.decodeResponse(req, &response)
+	columns, err := getAllPages[Column](c, req)
 	if err != nil {
 		return nil, err
 	}
 
-	return response, nil
+	// The API lists columns in board order. Position is not documented, so
+	// it only reorders the list when every column reports one.
+	if !slices.ContainsFunc(columns, func(column Column) bool { return column.Position == 0 }) {
+		sort.SliceStable(columns, func(i, j int) bool {
+			return columns[i].Position < columns[j].Position
+		})
+	}
+
+	return columns, nil
 }
 
 func (c *Client) GetColumn(ctx context.Context, columnID string) (*Column, error) {
@@ -66,6 +75,25 @@ func (c *Client) CreateColumn(ctx context.Context, payload CreateColumnPayload)
 	return err
 }
 
+// CreateColumnAndGetID creates a column on the selected board and returns its
+// ID, read from the Location header of the response.
+func (c *Client) CreateColumnAndGetID(ctx context.Context, payload CreateColumnPayload) (string, error) {
+	if c.BoardBaseURL == "" {
+		return "", ErrNoBoardSelected
+	}
+
+	endpointURL := c.BoardBaseURL + "/columns"
+
+	body := map[string]CreateColumnPayload{"column": payload}
+
+	req, err := c.newRequest(ctx, http.MethodPost, endpointURL, body)
+	if err != nil {
+		return "", fmt.Errorf("failed to create column request: %w", err)
+	}
+
+	return c.createResource(req)
+}
+
 func (c *Client) UpdateColumn(ctx context.Context, columnID string, payload UpdateColumnPayload) error {
 	if c.BoardBaseURL == "" {
 		return ErrNoBoardSelected
@@ -107,3 +135,133 @@ func (c *Client) DeleteColumn(ctx context.Context, columnID string) error {
 
 	return nil
 }
+
+// ColumnPosition says where MoveColumn places a column: at an index in the
+// board's column order, or immediately before or after another column.
+// Build one with AtIndex, Before or After; the zero value is AtIndex(0).
+type ColumnPosition struct {
+	anchor columnAnchor
+	index  int
+	ref    string
+}
+
+// columnAnchor is what a ColumnPosition is relative to.
+type columnAnchor int
+
+const (
+	anchorIndex columnAnchor = iota
+	anchorBefore
+	anchorAfter
+)
+
+// AtIndex places a column at index i (0 is leftmost). Indexes past the end
+// place it last.
+func AtIndex(i int) ColumnPosition {
+	return ColumnPosition{anchor: anchorIndex, index: i}
+}
+
+// Before places a column immediately left of columnID.
+func Before(columnID string) ColumnPosition {
+	return ColumnPosition{anchor: anchorBefore, ref: columnID}
+}
+
+// After places a column immediately right of columnID.
+func After(columnID string) ColumnPosition {
+	return ColumnPosition{anchor: anchorAfter, ref: columnID}
+}
+
+// MoveColumn moves a column within its board. The API moves columns one
+// place at a time, so this issues one request per place moved.
+func (c *Client) MoveColumn(ctx context.Context, columnID string, position ColumnPosition) error {
+	columns, err := c.GetColumns(ctx)
+	if err != nil {
+		return err
+	}
+
+	order := make([]string, len(columns))
+	for i, column := range columns {
+		order[i] = column.ID
+	}
+
+	_, err = c.moveColumn(ctx, order, columnID, position)
+	return err
+}
+
+// ReorderColumns arranges the board's columns in the order of columnIDs.
+// Columns not listed keep their relative order after the listed ones. Only
+// columns out of place are moved.
+func (c *Client) ReorderColumns(ctx context.Context, columnIDs []string) error {
+	columns, err := c.GetColumns(ctx)
+	if err != nil {
+		return err
+	}
+
+	order := make([]string, len(columns))
+	for i, column := range columns {
+		order[i] = column.ID
+	}
+
+	for i, id := range columnIDs {
+		if order, err = c.moveColumn(ctx, order, id, AtIndex(i)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// moveColumn moves columnID within order, the board's current column IDs,
+// and returns the new order.
+func (c *Client) moveColumn(ctx context.Context, order []string, columnID string, position ColumnPosition) ([]string, error) {
+	from := slices.Index(order, columnID)
+	if from < 0 {
+		return order, fmt.Errorf("column %s is not on the board", columnID)
+	}
+
+	to := position.index
+	switch position.anchor {
+	case anchorIndex:
+	case anchorBefore, anchorAfter:
+		if position.ref == "" {
+			return order, fmt.Errorf("column position has no reference column")
+		}
+		if position.ref == columnID {
+			return order, nil
+		}
+		at := slices.Index(order, position.ref)
+		if at < 0 {
+			return order, fmt.Errorf("column %s is not on the board", position.ref)
+		}
+		if at > from {
+			at-- // indexes shift once the column is taken out
+		}
+		to = at
+		if position.anchor == anchorAfter {
+			to++
+		}
+	default:
+		return order, fmt.Errorf("invalid column position")
+	}
+	to = max(0, min(to, len(order)-1))
+
+	direction := "right_position"
+	step := 1
+	if to < from {
+		direction = "left_position"
+		step = -1
+	}
+
+	for i := from; i != to; i += step {
+		endpointURL := c.BoardBaseURL + "/columns/" + columnID + "/" + direction
+
+		req, err := c.newRequest(ctx, http.MethodPost, endpointURL, nil)
+		if err != nil {
+			return order, fmt.Errorf("failed to create move column request: %w", err)
+		}
+		if _, err := c.decodeResponse(req, nil, http.StatusNoContent); err != nil {
+			return order, err
+		}
+		order[i], order[i+step] = order[i+step], order[i]
+	}
+
+	return order, nil
+}
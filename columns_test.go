@@ -6,6 +6,8 @@ import (
 	"errors"
 	"net/http"
 	"net/http/httptest"
+	"slices"
+	"strings"
 	"testing"
 )
 
@@ -37,6 +39,23 @@ func TestGetColumns(t *testing.T) {
 		}
 	})
 
+	t.Run("keeps the server's order without positions", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			json.NewEncoder(w).Encode([]Column{{ID: "col-2", Position: 2}, {ID: "col-1"}})
+		}))
+		defer server.Close()
+
+		client, _ := NewClient("/test-account", "test-token", WithBaseURL(server.URL), WithBoard("board-1"))
+		result, err := client.GetColumns(context.Background())
+
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(result) != 2 || result[0].ID != "col-2" {
+			t.Errorf("expected the listed order, got %+v", result)
+		}
+	})
+
 	t.Run("returns error when no board selected", func(t *testing.T) {
 		client, _ := NewClient("/test-account", "test-token")
 		_, err := client.GetColumns(context.Background())
@@ -117,6 +136,30 @@ func TestCreateColumn(t *testing.T) {
 	})
 }
 
+func TestCreateColumnAndGetID(t *testing.T) {
+	t.Run("returns ID from Location header", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/test-account/boards/board-1/columns" {
+				t.Errorf("unexpected path: %s", r.URL.Path)
+			}
+
+			w.Header().Set("Location", "/test-account/boards/board-1/columns/col-7.json")
+			w.WriteHeader(http.StatusCreated)
+		}))
+		defer server.Close()
+
+		client, _ := NewClient("/test-account", "test-token", WithBaseURL(server.URL), WithBoard("board-1"))
+		id, err := client.CreateColumnAndGetID(context.Background(), CreateColumnPayload{Name: "Doing"})
+
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if id != "col-7" {
+			t.Errorf("expected column ID 'col-7', got '%s'", id)
+		}
+	})
+}
+
 func TestUpdateColumn(t *testing.T) {
 	t.Run("updates column on success", func(t *testing.T) {
 		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -156,3 +199,117 @@ func TestDeleteColumn(t *testing.T) {
 		}
 	})
 }
+
+// newColumnOrderServer serves a board whose columns start in the given order
+// and applies left_position/right_position moves to it.
+func newColumnOrderServer(t *testing.T, order []string, moves *int) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path := strings.TrimPrefix(r.URL.Path, "/test-account/boards/board-1/columns")
+		if r.Method == http.MethodGet && path == "" {
+			columns := make([]Column, len(order))
+			for i, id := range order {
+				columns[i] = Column{ID: id, Name: id, Position: i + 1}
+			}
+			json.NewEncoder(w).Encode(columns)
+			return
+		}
+
+		parts := strings.Split(strings.TrimPrefix(path, "/"), "/")
+		i := slices.Index(order, parts[0])
+		switch parts[1] {
+		case "left_position":
+			order[i-1], order[i] = order[i], order[i-1]
+		case "right_position":
+			order[i+1], order[i] = order[i], order[i+1]
+		default:
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		*moves++
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestMoveColumn(t *testing.T) {
+	tests := []struct {
+		name     string
+		column   string
+		position ColumnPosition
+		expected string
+		moves    int
+	}{
+		{"to index", "D", AtIndex(1), "A,D,B,C", 2},
+		{"past the end", "A", AtIndex(10), "B,C,D,A", 3},
+		{"before a later column", "A", Before("C"), "B,A,C,D", 1},
+		{"after a later column", "A", After("C"), "B,C,A,D", 2},
+		{"before an earlier column", "D", Before("B"), "A,D,B,C", 2},
+		{"already in place", "B", After("A"), "A,B,C,D", 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			order := []string{"A", "B", "C", "D"}
+			moves := 0
+			server := newColumnOrderServer(t, order, &moves)
+
+			client, _ := NewClient("/test-account", "test-token", WithBaseURL(server.URL), WithBoard("board-1"))
+			err := client.MoveColumn(context.Background(), tt.column, tt.position)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if strings.Join(order, ",") != tt.expected {
+				t.Errorf("expected order %s, got %v", tt.expected, order)
+			}
+			if moves != tt.moves {
+				t.Errorf("expected %d moves, got %d", tt.moves, moves)
+			}
+		})
+	}
+
+	t.Run("returns error for unknown column", func(t *testing.T) {
+		moves := 0
+		server := newColumnOrderServer(t, []string{"A"}, &moves)
+
+		client, _ := NewClient("/test-account", "test-token", WithBaseURL(server.URL), WithBoard("board-1"))
+		err := client.MoveColumn(context.Background(), "Z", AtIndex(0))
+
+		if err == nil {
+			t.Fatal("expected error, got nil")
+		}
+	})
+
+	t.Run("rejects a position without a reference column", func(t *testing.T) {
+		moves := 0
+		server := newColumnOrderServer(t, []string{"A", "B"}, &moves)
+
+		client, _ := NewClient("/test-account", "test-token", WithBaseURL(server.URL), WithBoard("board-1"))
+		err := client.MoveColumn(context.Background(), "A", After(""))
+
+		if err == nil || moves != 0 {
+			t.Errorf("expected error without moves, got %v after %d moves", err, moves)
+		}
+	})
+}
+
+func TestReorderColumns(t *testing.T) {
+	order := []string{"A", "B", "C", "D"}
+	moves := 0
+	server := newColumnOrderServer(t, order, &moves)
+
+	client, _ := NewClient("/test-account", "test-token", WithBaseURL(server.URL), WithBoard("board-1"))
+	err := client.ReorderColumns(context.Background(), []string{"C", "A"})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if strings.Join(order, ",") != "C,A,B,D" {
+		t.Errorf("unexpected order: %v", order)
+	}
+	if moves != 2 {
+		t.Errorf("expected 2 moves, got %d", moves)
+	}
+}
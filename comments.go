@@ -3,7 +3,6 @@ package fizzy
 import (
 	"context"
 	"fmt"
-	"io"
 	"net/http"
 )
 
@@ -15,13 +14,7 @@ func (c *Client) GetCardComments(ctx context.Context, cardNumber int) ([]Comment
 		return nil, fmt.Errorf("failed to create get card comments request: %w", err)
 	}
 
-	var response []Comment
-	_, err = c.decodeResponse(req, &response)
-	if err != nil {
-		return nil, err
-	}
-
-	return response, nil
+	return getAllPages[Comment](c, req)
 }
 
 func (c *Client) GetCardComment(ctx context.Context, cardNumber int, commentID string) (*Comment, error) {
@@ -41,13 +34,25 @@ func (c *Client) GetCardComment(ctx context.Context, cardNumber int, commentID s
 	return &response, nil
 }
 
-// CreateCardComment creates a comment on a card. Returns an empty Comment
-// as the API only returns a Location header, not the created resource.
+// CreateCardComment creates a comment on a card. Returns a Comment with only
+// the ID set (when the API provides one), as the API only returns a Location
+// header, not the created resource.
 func (c *Client) CreateCardComment(ctx context.Context, cardNumber int, body string) (*Comment, error) {
+	return c.CreateCardCommentAt(ctx, cardNumber, body, "")
+}
+
+// CreateCardCommentAt creates a comment on a card, overriding its creation
+// timestamp with createdAt (ISO 8601) when non-empty. This is mostly useful
+// when importing history from another system.
+func (c *Client) CreateCardCommentAt(ctx context.Context, cardNumber int, body string, createdAt string) (*Comment, error) {
 	endpointURL := fmt.Sprintf("%s/cards/%d/comments", c.AccountBaseURL, cardNumber)
 
+	comment := map[string]string{"body": body}
+	if createdAt != "" {
+		comment["created_at"] = createdAt
+	}
 	payload := map[string]map[string]string{
-		"comment": {"body": body},
+		"comment": comment,
 	}
 
 	req, err := c.newRequest(ctx, http.MethodPost, endpointURL, payload)
@@ -55,18 +60,13 @@ func (c *Client) CreateCardComment(ctx context.Context, cardNumber int, body str
 		return nil, fmt.Errorf("failed to create post card comment request: %w", err)
 	}
 
-	res, err := c.HTTPClient.Do(req)
+	header, _, err := c.doRequest(req, nil, http.StatusCreated)
 	if err != nil {
-		return nil, fmt.Errorf("failed to make request: %w", err)
-	}
-	defer res.Body.Close()
-
-	if res.StatusCode != http.StatusCreated {
-		body, _ := io.ReadAll(res.Body)
-		return nil, fmt.Errorf("unexpected status code %d: %s", res.StatusCode, string(body))
+		return nil, err
 	}
 
-	return &Comment{}, nil
+	id, _ := idFromLocation(header.Get("Location"))
+	return &Comment{ID: id}, nil
 }
 
 func (c *Client) UpdateCardComment(ctx context.Context, cardNumber int, commentID string, body string) (*Comment, error) {
@@ -92,6 +92,32 @@ func TestCreateCardComment(t *testing.T) {
 	})
 }
 
+func TestCreateCardCommentAt(t *testing.T) {
+	t.Run("sends created_at and returns ID from Location header", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var body map[string]map[string]string
+			json.NewDecoder(r.Body).Decode(&body)
+			if body["comment"]["created_at"] != "2025-01-02T03:04:05Z" {
+				t.Errorf("expected created_at '2025-01-02T03:04:05Z', got '%s'", body["comment"]["created_at"])
+			}
+
+			w.Header().Set("Location", "/test-account/cards/42/comments/comment-3.json")
+			w.WriteHeader(http.StatusCreated)
+		}))
+		defer server.Close()
+
+		client, _ := NewClient("/test-account", "test-token", WithBaseURL(server.URL))
+		result, err := client.CreateCardCommentAt(context.Background(), 42, "Imported", "2025-01-02T03:04:05Z")
+
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if result.ID != "comment-3" {
+			t.Errorf("expected comment ID 'comment-3', got '%s'", result.ID)
+		}
+	})
+}
+
 func TestUpdateCardComment(t *testing.T) {
 	t.Run("updates comment on success", func(t *testing.T) {
 		comment := Comment{ID: "comment-1"}
@@ -0,0 +1,299 @@
+// Package digest turns unread notifications into a periodic summary.
+//
+// Notifications are grouped by board and card, and each group summarizes
+// its activity, such as "3 comments, 1 assignment". Digests render as
+// plain text, Markdown or HTML, ready for an email body:
+//
+//	d, err := digest.Build(ctx, client, digest.Options{})
+//	if !d.Empty() {
+//		err = d.Render(&body, digest.HTML)
+//		// send body, then
+//		err = d.MarkRead(ctx, client)
+//	}
+//
+// Deliver does all of this given a function that sends the digest.
+package digest
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	fizzy "github.com/rogeriopvl/fizzy-go"
+)
+
+// Kinds of activity notifications are about, see Classify.
+const (
+	KindComment    = "comment"
+	KindAssignment = "assignment"
+	KindMention    = "mention"
+	KindClosure    = "closure"
+	KindReopening  = "reopening"
+	KindMove       = "move"
+	KindPostpone   = "postponement"
+)
+
+var plurals = map[string]string{
+	KindComment:    "comments",
+	KindAssignment: "assignments",
+	KindMention:    "mentions",
+	KindClosure:    "closures",
+	KindReopening:  "reopenings",
+	KindMove:       "moves",
+	KindPostpone:   "postponements",
+}
+
+// Classify guesses what a notification is about from its body, which Fizzy
+// sets to a description of the event, such as "Assigned to Ana", or to the
+// text of the comment. Anything else is taken to be a comment.
+func Classify(n fizzy.Notification) string {
+	body := strings.ToLower(strings.TrimSpace(n.Body))
+	switch {
+	case strings.HasPrefix(body, "assigned to"):
+		return KindAssignment
+	case strings.Contains(body, "mentioned you"):
+		return KindMention
+	case strings.HasPrefix(body, "closed"):
+		return KindClosure
+	case strings.HasPrefix(body, "reopened"):
+		return KindReopening
+	case strings.HasPrefix(body, "moved to not now"), strings.HasPrefix(body, "postponed"):
+		return KindPostpone
+	case strings.HasPrefix(body, "moved to"), strings.HasPrefix(body, "moved from"):
+		return KindMove
+	}
+	return KindComment
+}
+
+// Activity counts notifications by kind.
+type Activity map[string]int
+
+// String summarizes the activity, most frequent kinds first:
+// "3 comments, 1 assignment".
+func (a Activity) String() string {
+	kinds := make([]string, 0, len(a))
+	for kind := range a {
+		kinds = append(kinds, kind)
+	}
+	sort.Slice(kinds, func(i, j int) bool {
+		if a[kinds[i]] != a[kinds[j]] {
+			return a[kinds[i]] > a[kinds[j]]
+		}
+		return kinds[i] < kinds[j]
+	})
+
+	parts := make([]string, len(kinds))
+	for i, kind := range kinds {
+		name := kind
+		if a[kind] != 1 {
+			if plural, ok := plurals[kind]; ok {
+				name = plural
+			} else {
+				name += "s"
+			}
+		}
+		parts[i] = fmt.Sprintf("%d %s", a[kind], name)
+	}
+	return strings.Join(parts, ", ")
+}
+
+func (a Activity) add(other Activity) {
+	for kind, n := range other {
+		a[kind] += n
+	}
+}
+
+// Digest is a set of notifications grouped by board and card.
+type Digest struct {
+	GeneratedAt time.Time
+	Total       int
+	Activity    Activity
+	Boards      []BoardGroup
+
+	notifications []fizzy.Notification
+}
+
+// BoardGroup holds a board's notified cards, most recently active first.
+// Notifications about cards that could not be found are grouped under a
+// board named "Other".
+type BoardGroup struct {
+	ID       string
+	Name     string
+	Activity Activity
+	Cards    []CardGroup
+}
+
+// CardGroup holds a card's notifications, newest first.
+type CardGroup struct {
+	Card          fizzy.CardReference
+	Number        int
+	Activity      Activity
+	Notifications []fizzy.Notification
+	Latest        time.Time
+}
+
+// Empty reports whether the digest has no notifications.
+func (d *Digest) Empty() bool {
+	return d.Total == 0
+}
+
+// Notifications returns every notification in the digest.
+func (d *Digest) Notifications() []fizzy.Notification {
+	return d.notifications
+}
+
+// Options controls which notifications Build includes.
+type Options struct {
+	// Since leaves out notifications created before it.
+	Since time.Time
+
+	// Classify overrides the package's Classify.
+	Classify func(fizzy.Notification) string
+}
+
+// New groups notifications, using cards to find which board each
+// notification's card is on. Read notifications are left out.
+func New(notifications []fizzy.Notification, cards []fizzy.Card, opts Options) *Digest {
+	classify := opts.Classify
+	if classify == nil {
+		classify = Classify
+	}
+	byID := make(map[string]fizzy.Card, len(cards))
+	for _, card := range cards {
+		byID[card.ID] = card
+	}
+
+	d := &Digest{GeneratedAt: time.Now(), Activity: Activity{}}
+	boards := make(map[string]*BoardGroup)
+	cardGroups := make(map[string]*CardGroup)
+	var boardOrder []string
+	cardOrder := make(map[string][]string)
+	for _, n := range notifications {
+		created, _ := time.Parse(time.RFC3339Nano, n.CreatedAt)
+		if n.Read || (!opts.Since.IsZero() && created.Before(opts.Since)) {
+			continue
+		}
+		d.notifications = append(d.notifications, n)
+		d.Total++
+
+		card, found := byID[n.Card.ID]
+		boardID := ""
+		if found {
+			boardID = card.Board.ID
+		}
+		if _, ok := boards[boardID]; !ok {
+			name := card.Board.Name
+			if boardID == "" {
+				name = "Other"
+			}
+			boards[boardID] = &BoardGroup{ID: boardID, Name: name, Activity: Activity{}}
+			boardOrder = append(boardOrder, boardID)
+		}
+
+		group, ok := cardGroups[n.Card.ID]
+		if !ok {
+			group = &CardGroup{Card: n.Card, Number: card.Number, Activity: Activity{}}
+			cardGroups[n.Card.ID] = group
+			cardOrder[boardID] = append(cardOrder[boardID], n.Card.ID)
+		}
+		group.Notifications = append(group.Notifications, n)
+		group.Activity[classify(n)]++
+		if created.After(group.Latest) {
+			group.Latest = created
+		}
+	}
+
+	for _, boardID := range boardOrder {
+		board := boards[boardID]
+		for _, cardID := range cardOrder[boardID] {
+			group := cardGroups[cardID]
+			sort.SliceStable(group.Notifications, func(i, j int) bool {
+				return group.Notifications[i].CreatedAt > group.Notifications[j].CreatedAt
+			})
+			board.Cards = append(board.Cards, *group)
+			board.Activity.add(group.Activity)
+		}
+		sort.SliceStable(board.Cards, func(i, j int) bool { return board.Cards[i].Latest.After(board.Cards[j].Latest) })
+		d.Activity.add(board.Activity)
+		d.Boards = append(d.Boards, *board)
+	}
+	sort.SliceStable(d.Boards, func(i, j int) bool {
+		if (d.Boards[i].ID == "") != (d.Boards[j].ID == "") {
+			return d.Boards[j].ID == "" // Other goes last
+		}
+		return d.Boards[i].Name < d.Boards[j].Name
+	})
+	return d
+}
+
+// Build fetches the unread notifications and the cards they are about,
+// whether open, in Not Now or closed, and groups them.
+func Build(ctx context.Context, client *fizzy.Client, opts Options) (*Digest, error) {
+	notifications, err := client.GetNotifications(ctx, fizzy.NotificationFilters{
+		ReadStatus: fizzy.NotificationsUnread,
+		Since:      opts.Since,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list notifications: %w", err)
+	}
+
+	var ids []string
+	seen := make(map[string]bool)
+	for _, n := range notifications {
+		if !n.Read && n.Card.ID != "" && !seen[n.Card.ID] {
+			seen[n.Card.ID] = true
+			ids = append(ids, n.Card.ID)
+		}
+	}
+
+	var cards []fizzy.Card
+	if len(ids) > 0 {
+		for _, index := range []string{"all", "not_now", "closed"} {
+			found, err := client.GetCards(ctx, fizzy.CardFilters{CardIDs: ids, IndexedBy: index})
+			if err != nil {
+				return nil, fmt.Errorf("failed to list cards: %w", err)
+			}
+			cards = append(cards, found...)
+		}
+	}
+
+	return New(notifications, cards, opts), nil
+}
+
+// MarkRead marks every notification in the digest as read, continuing past
+// failures. Notifications that arrived after the digest was built are left
+// unread.
+func (d *Digest) MarkRead(ctx context.Context, client *fizzy.Client) error {
+	ids := make([]string, len(d.notifications))
+	for i, n := range d.notifications {
+		ids[i] = n.ID
+	}
+	return client.MarkNotificationsRead(ctx, ids)
+}
+
+// Deliver builds a digest and, unless it is empty, passes it to send and
+// marks its notifications read once send succeeds. With markAll it marks
+// every notification read in one request instead, including any that
+// arrived while sending.
+func Deliver(ctx context.Context, client *fizzy.Client, opts Options, markAll bool, send func(*Digest) error) (*Digest, error) {
+	d, err := Build(ctx, client, opts)
+	if err != nil || d.Empty() {
+		return d, err
+	}
+
+	if err := send(d); err != nil {
+		return d, fmt.Errorf("failed to send digest: %w", err)
+	}
+
+	if markAll {
+		err = client.MarkAllNotificationsRead(ctx)
+	} else {
+		err = d.MarkRead(ctx, client)
+	}
+	if err != nil {
+		return d, fmt.Errorf("failed to mark notifications read: %w", err)
+	}
+	return d, nil
+}
@@ -0,0 +1,204 @@
+package digest
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+
+	fizzy "github.com/rogeriopvl/fizzy-go"
+)
+
+var testNotifications = []fizzy.Notification{
+	{ID: "n1", CreatedAt: "2025-12-06T10:00:00Z", Body: "Looks good to me", Creator: fizzy.User{Name: "Ana"}, Card: fizzy.CardReference{ID: "c1", Title: "Fix login", URL: "https://app.fizzy.do/1/cards/1"}},
+	{ID: "n2", CreatedAt: "2025-12-06T11:00:00Z", Body: "Assigned to Bo", Creator: fizzy.User{Name: "Ana"}, Card: fizzy.CardReference{ID: "c1", Title: "Fix login"}},
+	{ID: "n3", CreatedAt: "2025-12-06T12:00:00Z", Body: "Can you check?", Creator: fizzy.User{Name: "Cy"}, Card: fizzy.CardReference{ID: "c1", Title: "Fix login"}},
+	{ID: "n4", CreatedAt: "2025-12-06T09:00:00Z", Body: "Closed", Creator: fizzy.User{Name: "Bo"}, Card: fizzy.CardReference{ID: "c2", Title: "Ship it"}},
+	{ID: "n5", CreatedAt: "2025-12-06T13:00:00Z", Body: "Agreed", Creator: fizzy.User{Name: "Bo"}, Card: fizzy.CardReference{ID: "c3", Title: "Roadmap"}},
+	{ID: "n7", CreatedAt: "2025-12-06T08:00:00Z", Body: "Hello", Card: fizzy.CardReference{ID: "c9", Title: "Gone"}},
+	{ID: "n6", CreatedAt: "2025-12-06T13:00:00Z", Body: "Old news", Read: true, Card: fizzy.CardReference{ID: "c3", Title: "Roadmap"}},
+}
+
+var testCards = []fizzy.Card{
+	{ID: "c1", Number: 1, Board: fizzy.Board{ID: "b1", Name: "Product"}},
+	{ID: "c2", Number: 2, Board: fizzy.Board{ID: "b1", Name: "Product"}},
+	{ID: "c3", Number: 3, Board: fizzy.Board{ID: "b2", Name: "Marketing"}},
+}
+
+func TestClassify(t *testing.T) {
+	for body, want := range map[string]string{
+		"Assigned to self":         KindAssignment,
+		"Ana mentioned you":        KindMention,
+		"Closed":                   KindClosure,
+		"Reopened":                 KindReopening,
+		"Moved to Doing":           KindMove,
+		"Moved to Not Now":         KindPostpone,
+		"I think we should retry.": KindComment,
+	} {
+		if got := Classify(fizzy.Notification{Body: body}); got != want {
+			t.Errorf("%q: expected %s, got %s", body, want, got)
+		}
+	}
+}
+
+func TestActivityString(t *testing.T) {
+	if got := (Activity{KindComment: 3, KindAssignment: 1}).String(); got != "3 comments, 1 assignment" {
+		t.Errorf("unexpected summary: %q", got)
+	}
+	if got := (Activity{"update": 2}).String(); got != "2 updates" {
+		t.Errorf("unexpected summary for unknown kind: %q", got)
+	}
+}
+
+func TestNew(t *testing.T) {
+	d := New(testNotifications, testCards, Options{})
+
+	if d.Total != 6 || len(d.Notifications()) != 6 {
+		t.Errorf("expected 6 unread notifications, got %d", d.Total)
+	}
+	var boards []string
+	for _, board := range d.Boards {
+		boards = append(boards, board.Name)
+	}
+	if !slices.Equal(boards, []string{"Marketing", "Product", "Other"}) {
+		t.Fatalf("unexpected boards: %v", boards)
+	}
+
+	product := d.Boards[1]
+	if product.Activity.String() != "2 comments, 1 assignment, 1 closure" {
+		t.Errorf("unexpected board activity: %s", product.Activity)
+	}
+	if len(product.Cards) != 2 || product.Cards[0].Number != 1 || product.Cards[0].Activity.String() != "2 comments, 1 assignment" {
+		t.Errorf("unexpected cards: %+v", product.Cards)
+	}
+	if product.Cards[0].Notifications[0].ID != "n3" {
+		t.Errorf("expected newest notification first, got %s", product.Cards[0].Notifications[0].ID)
+	}
+}
+
+func TestBuild(t *testing.T) {
+	t.Run("groups unread notifications", func(t *testing.T) {
+		mux := http.NewServeMux()
+		mux.HandleFunc("GET /test-account/notifications", func(w http.ResponseWriter, r *http.Request) {
+			json.NewEncoder(w).Encode(testNotifications)
+		})
+		mux.HandleFunc("GET /test-account/cards", func(w http.ResponseWriter, r *http.Request) {
+			if ids := r.URL.Query()["card_ids[]"]; len(ids) != 4 {
+				t.Errorf("expected the 4 unread cards, got %v", ids)
+			}
+			switch r.URL.Query().Get("indexed_by") {
+			case "all":
+				json.NewEncoder(w).Encode(testCards[:1])
+			case "not_now":
+				json.NewEncoder(w).Encode(testCards[1:2])
+			case "closed":
+				json.NewEncoder(w).Encode(testCards[2:])
+			}
+		})
+		server := httptest.NewServer(mux)
+		defer server.Close()
+
+		client, _ := fizzy.NewClient("/test-account", "test-token", fizzy.WithBaseURL(server.URL))
+		d, err := Build(context.Background(), client, Options{})
+
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if d.Total != 6 || len(d.Boards) != 3 {
+			t.Errorf("unexpected digest: %d notifications on %d boards", d.Total, len(d.Boards))
+		}
+		if product := d.Boards[1]; product.Name != "Product" || len(product.Cards) != 2 {
+			t.Errorf("expected the Not Now card grouped under Product, got %+v", product)
+		}
+	})
+
+	t.Run("returns error on failure", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusInternalServerError)
+		}))
+		defer server.Close()
+
+		client, _ := fizzy.NewClient("/test-account", "test-token", fizzy.WithBaseURL(server.URL))
+		if _, err := Build(context.Background(), client, Options{}); err == nil {
+			t.Error("expected error, got nil")
+		}
+	})
+}
+
+func TestDeliver(t *testing.T) {
+	newServer := func(t *testing.T, read *[]string) *httptest.Server {
+		var mu sync.Mutex
+		mux := http.NewServeMux()
+		mux.HandleFunc("GET /test-account/notifications", func(w http.ResponseWriter, r *http.Request) {
+			json.NewEncoder(w).Encode(testNotifications[:2])
+		})
+		mux.HandleFunc("GET /test-account/cards", func(w http.ResponseWriter, r *http.Request) {
+			json.NewEncoder(w).Encode(testCards)
+		})
+		mux.HandleFunc("POST /test-account/notifications/", func(w http.ResponseWriter, r *http.Request) {
+			mu.Lock()
+			*read = append(*read, strings.TrimPrefix(r.URL.Path, "/test-account/notifications/"))
+			mu.Unlock()
+			w.WriteHeader(http.StatusNoContent)
+		})
+		server := httptest.NewServer(mux)
+		t.Cleanup(server.Close)
+		return server
+	}
+
+	t.Run("marks notifications read after sending", func(t *testing.T) {
+		var read []string
+		server := newServer(t, &read)
+		client, _ := fizzy.NewClient("/test-account", "test-token", fizzy.WithBaseURL(server.URL))
+
+		var sent string
+		_, err := Deliver(context.Background(), client, Options{}, false, func(d *Digest) error {
+			var b strings.Builder
+			d.Render(&b, Text)
+			sent = b.String()
+			return nil
+		})
+
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !strings.Contains(sent, "Fix login") {
+			t.Errorf("unexpected digest sent: %s", sent)
+		}
+		slices.Sort(read)
+		if !slices.Equal(read, []string{"n1/reading", "n2/reading"}) {
+			t.Errorf("unexpected notifications marked read: %v", read)
+		}
+	})
+
+	t.Run("marks everything read in one request", func(t *testing.T) {
+		var read []string
+		server := newServer(t, &read)
+		client, _ := fizzy.NewClient("/test-account", "test-token", fizzy.WithBaseURL(server.URL))
+
+		Deliver(context.Background(), client, Options{}, true, func(d *Digest) error { return nil })
+
+		if !slices.Equal(read, []string{"bulk_reading"}) {
+			t.Errorf("expected a bulk reading, got %v", read)
+		}
+	})
+
+	t.Run("leaves notifications unread when sending fails", func(t *testing.T) {
+		var read []string
+		server := newServer(t, &read)
+		client, _ := fizzy.NewClient("/test-account", "test-token", fizzy.WithBaseURL(server.URL))
+
+		_, err := Deliver(context.Background(), client, Options{}, false, func(d *Digest) error { return context.DeadlineExceeded })
+
+		if err == nil {
+			t.Error("expected error, got nil")
+		}
+		if len(read) != 0 {
+			t.Errorf("expected nothing marked read, got %v", read)
+		}
+	})
+}
@@ -0,0 +1,118 @@
+package digest
+
+import (
+	"fmt"
+	htmltemplate "html/template"
+	"io"
+	"strings"
+	"text/template"
+)
+
+// Format is an output format for Render.
+type Format string
+
+const (
+	Text     Format = "text"
+	Markdown Format = "markdown"
+	HTML     Format = "html"
+)
+
+// TextTemplate, MarkdownTemplate and HTMLTemplate are the templates Render
+// uses. They are executed with a *Digest and can call truncate, which cuts
+// a string to at most the given number of characters, and md, which
+// escapes Markdown.
+const (
+	TextTemplate = `You have {{.Total}} unread notification{{if ne .Total 1}}s{{end}}: {{.Activity}}.
+{{range .Boards}}
+{{.Name}} ({{.Activity}})
+{{range .Cards}}  * {{.Card.Title}}: {{.Activity}}
+{{range .Notifications}}      {{with .Creator.Name}}{{.}}: {{end}}{{truncate .Body 140}}
+{{end}}{{if .Card.URL}}      {{.Card.URL}}
+{{end}}{{end}}{{end}}`
+
+	MarkdownTemplate = `# {{.Total}} unread notification{{if ne .Total 1}}s{{end}}
+
+{{.Activity}}
+{{range .Boards}}
+## {{md .Name}}
+
+_{{.Activity}}_
+
+{{range .Cards}}- {{if .Card.URL}}[{{md .Card.Title}}]({{.Card.URL}}){{else}}{{md .Card.Title}}{{end}}: {{.Activity}}
+{{range .Notifications}}  - {{with .Creator.Name}}**{{md .}}**: {{end}}{{md (truncate .Body 140)}}
+{{end}}{{end}}{{end}}`
+
+	HTMLTemplate = `<!DOCTYPE html>
+<html>
+<body style="font-family: sans-serif">
+<h1>{{.Total}} unread notification{{if ne .Total 1}}s{{end}}</h1>
+<p>{{.Activity}}</p>
+{{range .Boards}}<h2>{{.Name}}</h2>
+<p><em>{{.Activity}}</em></p>
+<ul>
+{{range .Cards}}<li>{{if .Card.URL}}<a href="{{.Card.URL}}">{{.Card.Title}}</a>{{else}}{{.Card.Title}}{{end}}: {{.Activity}}
+<ul>
+{{range .Notifications}}<li>{{with .Creator.Name}}<strong>{{.}}</strong>: {{end}}{{truncate .Body 140}}</li>
+{{end}}</ul>
+</li>
+{{end}}</ul>
+{{end}}</body>
+</html>
+`
+)
+
+var (
+	textTemplate     = template.Must(template.New("text").Funcs(Funcs()).Parse(TextTemplate))
+	markdownTemplate = template.Must(template.New("markdown").Funcs(Funcs()).Parse(MarkdownTemplate))
+	htmlTemplate     = htmltemplate.Must(htmltemplate.New("html").Funcs(Funcs()).Parse(HTMLTemplate))
+)
+
+// Executor is a parsed text/template or html/template template.
+type Executor interface {
+	Execute(w io.Writer, data any) error
+}
+
+// Render writes the digest in the given format using the default
+// templates.
+func (d *Digest) Render(w io.Writer, format Format) error {
+	switch format {
+	case Text:
+		return d.RenderTemplate(w, textTemplate)
+	case Markdown:
+		return d.RenderTemplate(w, markdownTemplate)
+	case HTML:
+		return d.RenderTemplate(w, htmlTemplate)
+	}
+	return fmt.Errorf("unknown digest format %q", format)
+}
+
+// RenderTemplate writes the digest with a custom template. Parse it with
+// Funcs to use truncate and md:
+//
+//	tmpl := template.Must(template.New("digest").Funcs(digest.Funcs()).Parse(text))
+func (d *Digest) RenderTemplate(w io.Writer, tmpl Executor) error {
+	return tmpl.Execute(w, d)
+}
+
+// Funcs returns the functions available to the default templates.
+func Funcs() map[string]any {
+	return map[string]any{"truncate": truncate, "md": escapeMarkdown}
+}
+
+func truncate(s string, n int) string {
+	s = strings.Join(strings.Fields(s), " ")
+	runes := []rune(s)
+	if len(runes) <= n {
+		return s
+	}
+	return strings.TrimSpace(string(runes[:n-1])) + "…"
+}
+
+var markdownEscaper = strings.NewReplacer(
+	`\`, `\\`, "`", "\\`", "*", `\*`, "_", `\_`, "[", `\[`, "]", `\]`,
+	"<", `\<`, ">", `\>`, "#", `\#`, "|", `\|`,
+)
+
+func escapeMarkdown(s string) string {
+	return markdownEscaper.Replace(s)
+}
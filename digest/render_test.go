@@ -0,0 +1,53 @@
+package digest
+
+import (
+	"strings"
+	"testing"
+	"text/template"
+
+	fizzy "github.com/rogeriopvl/fizzy-go"
+)
+
+func TestRender(t *testing.T) {
+	notifications := append([]fizzy.Notification{{
+		ID:        "n8",
+		CreatedAt: "2025-12-06T14:00:00Z",
+		Body:      "<script>alert(1)</script> *bold*",
+		Creator:   fizzy.User{Name: "Eve"},
+		Card:      fizzy.CardReference{ID: "c3", Title: "Roadmap"},
+	}}, testNotifications...)
+	d := New(notifications, testCards, Options{})
+
+	for format, want := range map[Format][]string{
+		Text:     {"You have 7 unread notifications: 5 comments, 1 assignment, 1 closure.", "Product (2 comments, 1 assignment, 1 closure)", "  * Fix login: 2 comments, 1 assignment", "      Cy: Can you check?", "      Hello", "https://app.fizzy.do/1/cards/1"},
+		Markdown: {"# 7 unread notifications", "## Product", "- [Fix login](https://app.fizzy.do/1/cards/1): 2 comments, 1 assignment", `**Eve**: \<script\>alert(1)\</script\> \*bold\*`},
+		HTML:     {"<h2>Product</h2>", `<a href="https://app.fizzy.do/1/cards/1">Fix login</a>`, "&lt;script&gt;alert(1)&lt;/script&gt;"},
+	} {
+		var b strings.Builder
+		if err := d.Render(&b, format); err != nil {
+			t.Fatalf("%s: unexpected error: %v", format, err)
+		}
+		for _, s := range want {
+			if !strings.Contains(b.String(), s) {
+				t.Errorf("%s: expected output to contain %q, got:\n%s", format, s, b.String())
+			}
+		}
+	}
+
+	if err := d.Render(&strings.Builder{}, "pdf"); err == nil {
+		t.Error("expected error for unknown format, got nil")
+	}
+}
+
+func TestRenderTemplate(t *testing.T) {
+	d := New(testNotifications, testCards, Options{})
+	tmpl := template.Must(template.New("digest").Funcs(Funcs()).Parse(`{{range .Boards}}{{.Name}}:{{len .Cards}} {{end}}{{truncate "a long sentence" 7}}`))
+
+	var b strings.Builder
+	if err := d.RenderTemplate(&b, tmpl); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := b.String(); got != "Marketing:1 Product:2 Other:1 a long…" {
+		t.Errorf("unexpected output: %q", got)
+	}
+}
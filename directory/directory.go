@@ -0,0 +1,122 @@
+// Package directory keeps a Fizzy account's users in step with an external
+// directory such as an identity provider.
+//
+// The desired users come from a Source: a CSV or JSON file read with ReadCSV
+// or ReadJSON, or any implementation that queries a directory service. Diff
+// matches them to the account's users by email and plans renames, role
+// changes, deactivations and invitations; Apply carries the plan out and
+// returns an audit report:
+//
+//	people, err := directory.ReadCSV(file)
+//	plan, err := directory.Diff(ctx, client, people, directory.Options{DeactivateMissing: true})
+//	fmt.Print(plan)
+//	report, err := directory.Apply(ctx, client, plan, directory.ApplyOptions{AllowDestructive: true})
+//
+// Deactivations are destructive: Apply refuses them unless AllowDestructive
+// is set, and Diff never deactivates the owner or the user the client acts
+// as.
+//
+// The API cannot create accounts for people, so invitations are planned as
+// manual changes carrying the account's join link.
+package directory
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	fizzy "github.com/rogeriopvl/fizzy-go"
+)
+
+// Person is a user as the directory describes them. Empty Name and Role are
+// not managed.
+type Person struct {
+	Email string `json:"email"`
+	Name  string `json:"name,omitempty"`
+	Role  string `json:"role,omitempty"`
+}
+
+// Source supplies the people who should have access to the account.
+type Source interface {
+	People(ctx context.Context) ([]Person, error)
+}
+
+// People is a fixed list of people, usable as a Source.
+type People []Person
+
+func (p People) People(ctx context.Context) ([]Person, error) {
+	return p, nil
+}
+
+// ReadCSV reads people from CSV with a header row naming the email, name and
+// role columns, in any order. Only email is required.
+func ReadCSV(r io.Reader) (People, error) {
+	records, err := csv.NewReader(r).ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read directory CSV: %w", err)
+	}
+	if len(records) == 0 {
+		return nil, nil
+	}
+
+	columns := map[string]int{"email": -1, "name": -1, "role": -1}
+	for i, header := range records[0] {
+		if _, ok := columns[strings.ToLower(strings.TrimSpace(header))]; ok {
+			columns[strings.ToLower(strings.TrimSpace(header))] = i
+		}
+	}
+	if columns["email"] < 0 {
+		return nil, fmt.Errorf("directory CSV has no email column")
+	}
+
+	field := func(record []string, column string) string {
+		if i := columns[column]; i >= 0 && i < len(record) {
+			return strings.TrimSpace(record[i])
+		}
+		return ""
+	}
+
+	people := make(People, 0, len(records)-1)
+	for _, record := range records[1:] {
+		people = append(people, Person{
+			Email: field(record, "email"),
+			Name:  field(record, "name"),
+			Role:  strings.ToLower(field(record, "role")),
+		})
+	}
+	return people, validate(people)
+}
+
+// ReadJSON reads people from a JSON array of objects with email, name and
+// role fields.
+func ReadJSON(r io.Reader) (People, error) {
+	var people People
+	if err := json.NewDecoder(r).Decode(&people); err != nil {
+		return nil, fmt.Errorf("failed to decode directory JSON: %w", err)
+	}
+	return people, validate(people)
+}
+
+func validate(people []Person) error {
+	seen := make(map[string]bool, len(people))
+	for i, person := range people {
+		email := strings.ToLower(person.Email)
+		if email == "" {
+			return fmt.Errorf("directory: person %d has no email", i+1)
+		}
+		if seen[email] {
+			return fmt.Errorf("directory: %s is listed twice", person.Email)
+		}
+		seen[email] = true
+
+		switch person.Role {
+		case "", fizzy.RoleAdmin, fizzy.RoleMember:
+		default:
+			return fmt.Errorf("directory: %s has invalid role %q", person.Email, person.Role)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+package directory
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestReadCSV(t *testing.T) {
+	t.Run("reads columns in any order", func(t *testing.T) {
+		people, err := ReadCSV(strings.NewReader("Name,Email,Role\nAna Silva,ana@example.com,Admin\nBob,bob@example.com,\n"))
+
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(people) != 2 {
+			t.Fatalf("expected 2 people, got %d", len(people))
+		}
+		if people[0] != (Person{Email: "ana@example.com", Name: "Ana Silva", Role: "admin"}) {
+			t.Errorf("unexpected person: %+v", people[0])
+		}
+		if people[1].Role != "" {
+			t.Errorf("expected empty role, got %q", people[1].Role)
+		}
+	})
+
+	t.Run("requires an email column", func(t *testing.T) {
+		_, err := ReadCSV(strings.NewReader("name\nAna\n"))
+		if err == nil {
+			t.Fatal("expected error, got nil")
+		}
+	})
+
+	t.Run("rejects duplicates", func(t *testing.T) {
+		_, err := ReadCSV(strings.NewReader("email\nana@example.com\nANA@example.com\n"))
+		if err == nil {
+			t.Fatal("expected error, got nil")
+		}
+	})
+}
+
+func TestReadJSON(t *testing.T) {
+	t.Run("reads people", func(t *testing.T) {
+		people, err := ReadJSON(strings.NewReader(`[{"email": "ana@example.com", "name": "Ana", "role": "member"}]`))
+
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(people) != 1 || people[0].Name != "Ana" {
+			t.Errorf("unexpected people: %+v", people)
+		}
+	})
+
+	t.Run("rejects invalid roles", func(t *testing.T) {
+		_, err := ReadJSON(strings.NewReader(`[{"email": "ana@example.com", "role": "owner"}]`))
+		if err == nil {
+			t.Fatal("expected error, got nil")
+		}
+	})
+}
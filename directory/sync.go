@@ -0,0 +1,308 @@
+package directory
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	fizzy "github.com/rogeriopvl/fizzy-go"
+)
+
+// ErrEmptyDirectory is returned by Diff when the source lists nobody, which
+// more likely means a truncated export than an empty organisation.
+var ErrEmptyDirectory = errors.New("directory lists no people")
+
+// ErrDestructive is returned by Apply when the plan deactivates users and
+// destructive changes were not allowed.
+var ErrDestructive = errors.New("plan deactivates users")
+
+// Action is what a Change does to a user.
+type Action string
+
+const (
+	ActionInvite     Action = "invite"
+	ActionRename     Action = "rename"
+	ActionRole       Action = "role"
+	ActionDeactivate Action = "deactivate"
+)
+
+// Options controls what Diff plans.
+type Options struct {
+	// DeactivateMissing deactivates users who are not in the directory.
+	// Without it they are left alone.
+	DeactivateMissing bool
+
+	// Protected lists emails that are never deactivated or demoted, such as
+	// service accounts. The account owner and the user the client acts as
+	// are always protected.
+	Protected []string
+
+	// AllowDestructive lets Sync apply plans that deactivate users. Diff
+	// plans deactivations either way.
+	AllowDestructive bool
+}
+
+// Change is a single planned change to one user.
+type Change struct {
+	Action Action
+	Email  string
+	UserID string
+	From   string
+	To     string
+
+	// Manual is set for changes the API cannot make, which have to be done
+	// by hand.
+	Manual bool
+}
+
+func (ch Change) String() string {
+	var s string
+	switch ch.Action {
+	case ActionInvite:
+		s = fmt.Sprintf("+ invite %s", ch.Email)
+		if ch.To != "" {
+			s += " via " + ch.To
+		}
+	case ActionRename:
+		s = fmt.Sprintf("~ rename %s: %q -> %q", ch.Email, ch.From, ch.To)
+	case ActionRole:
+		s = fmt.Sprintf("~ change role of %s: %s -> %s", ch.Email, ch.From, ch.To)
+	case ActionDeactivate:
+		s = fmt.Sprintf("- deactivate %s", ch.Email)
+	}
+	if ch.Manual {
+		s += " (manual)"
+	}
+	return s
+}
+
+// Plan lists the changes that bring the account in line with the directory.
+type Plan struct {
+	Changes []Change
+}
+
+func (p *Plan) Empty() bool {
+	return len(p.Changes) == 0
+}
+
+// Destructive reports whether the plan deactivates anyone.
+func (p *Plan) Destructive() bool {
+	for _, ch := range p.Changes {
+		if ch.Action == ActionDeactivate {
+			return true
+		}
+	}
+	return false
+}
+
+// String renders the plan one change per line; it doubles as the dry-run
+// output.
+func (p *Plan) String() string {
+	if p.Empty() {
+		return "No changes.\n"
+	}
+	var b strings.Builder
+	for _, ch := range p.Changes {
+		b.WriteString(ch.String() + "\n")
+	}
+	return b.String()
+}
+
+// Diff compares the people from source with the account's active users and
+// plans the changes needed to match. It does not modify anything. A source
+// listing nobody is refused with ErrEmptyDirectory.
+func Diff(ctx context.Context, client *fizzy.Client, source Source, opts Options) (*Plan, error) {
+	people, err := source.People(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read directory: %w", err)
+	}
+	if len(people) == 0 {
+		return nil, ErrEmptyDirectory
+	}
+	if err := validate(people); err != nil {
+		return nil, err
+	}
+
+	users, err := client.GetUsers(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list users: %w", err)
+	}
+	byEmail := make(map[string]fizzy.User, len(users))
+	for _, user := range users {
+		if user.Active {
+			byEmail[strings.ToLower(user.Email)] = user
+		}
+	}
+
+	protected := make(map[string]bool, len(opts.Protected))
+	for _, email := range opts.Protected {
+		protected[strings.ToLower(email)] = true
+	}
+
+	plan := &Plan{}
+	listed := make(map[string]bool, len(people))
+	var invites []Change
+	for _, person := range people {
+		email := strings.ToLower(person.Email)
+		listed[email] = true
+
+		user, exists := byEmail[email]
+		if !exists {
+			invites = append(invites, Change{Action: ActionInvite, Email: person.Email, Manual: true})
+			continue
+		}
+
+		if person.Name != "" && person.Name != user.Name {
+			plan.Changes = append(plan.Changes, Change{Action: ActionRename, Email: user.Email, UserID: user.ID, From: user.Name, To: person.Name})
+		}
+
+		demoting := person.Role == fizzy.RoleMember && protected[email]
+		if person.Role != "" && person.Role != user.Role && user.Role != fizzy.RoleOwner && !demoting {
+			plan.Changes = append(plan.Changes, Change{Action: ActionRole, Email: user.Email, UserID: user.ID, From: user.Role, To: person.Role})
+		}
+	}
+
+	if opts.DeactivateMissing {
+		self, err := callerIDs(ctx, client)
+		if err != nil {
+			return nil, err
+		}
+		for _, user := range users {
+			email := strings.ToLower(user.Email)
+			if !user.Active || listed[email] || protected[email] || user.Role == fizzy.RoleOwner || self[user.ID] {
+				continue
+			}
+			plan.Changes = append(plan.Changes, Change{Action: ActionDeactivate, Email: user.Email, UserID: user.ID})
+		}
+	}
+
+	if len(invites) > 0 {
+		// The join link is a convenience for whoever sends the invitations;
+		// plans are still useful without it.
+		if code, err := client.GetJoinCode(ctx); err == nil {
+			for i := range invites {
+				invites[i].To = code.URL
+			}
+		}
+		plan.Changes = append(plan.Changes, invites...)
+	}
+
+	return plan, nil
+}
+
+// callerIDs returns the IDs of the users the client's token acts as, so Diff
+// never plans to lock the caller out.
+func callerIDs(ctx context.Context, client *fizzy.Client) (map[string]bool, error) {
+	identity, err := client.GetMyIdentity(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to identify the current user: %w", err)
+	}
+	ids := make(map[string]bool, len(identity.Accounts))
+	for _, account := range identity.Accounts {
+		ids[account.User.ID] = true
+	}
+	return ids, nil
+}
+
+// Entry records the outcome of one change in a Report.
+type Entry struct {
+	Change  Change
+	Applied bool
+	Err     error
+	At      time.Time
+}
+
+// Report is the audit trail of an Apply.
+type Report struct {
+	Entries []Entry
+}
+
+// Failed returns the entries whose change could not be made.
+func (r *Report) Failed() []Entry {
+	var failed []Entry
+	for _, entry := range r.Entries {
+		if entry.Err != nil {
+			failed = append(failed, entry)
+		}
+	}
+	return failed
+}
+
+// String renders the report one line per change with its timestamp and
+// outcome.
+func (r *Report) String() string {
+	var b strings.Builder
+	for _, entry := range r.Entries {
+		status := "ok"
+		switch {
+		case entry.Err != nil:
+			status = "failed: " + entry.Err.Error()
+		case !entry.Applied:
+			status = "skipped (manual)"
+		}
+		fmt.Fprintf(&b, "%s %s: %s\n", entry.At.UTC().Format(time.RFC3339), entry.Change, status)
+	}
+	return b.String()
+}
+
+// ApplyOptions configures Apply.
+type ApplyOptions struct {
+	// AllowDestructive permits plans that deactivate users.
+	AllowDestructive bool
+}
+
+// Apply makes the planned changes, continuing past failures, and reports on
+// each. Manual changes are recorded as skipped. A plan that deactivates
+// users is refused with ErrDestructive, before any change is made, unless
+// opts.AllowDestructive is set.
+func Apply(ctx context.Context, client *fizzy.Client, plan *Plan, opts ApplyOptions) (*Report, error) {
+	if plan.Destructive() && !opts.AllowDestructive {
+		var deactivations []string
+		for _, ch := range plan.Changes {
+			if ch.Action == ActionDeactivate {
+				deactivations = append(deactivations, ch.String())
+			}
+		}
+		return nil, fmt.Errorf("%w:\n%s", ErrDestructive, strings.Join(deactivations, "\n"))
+	}
+
+	report := &Report{Entries: make([]Entry, 0, len(plan.Changes))}
+
+	for _, ch := range plan.Changes {
+		entry := Entry{Change: ch}
+		if !ch.Manual {
+			entry.Err = apply(ctx, client, ch)
+			entry.Applied = entry.Err == nil
+		}
+		entry.At = time.Now()
+		report.Entries = append(report.Entries, entry)
+	}
+
+	return report, nil
+}
+
+func apply(ctx context.Context, client *fizzy.Client, ch Change) error {
+	switch ch.Action {
+	case ActionRename:
+		return client.UpdateUser(ctx, ch.UserID, fizzy.UpdateUserPayload{Name: ch.To})
+	case ActionRole:
+		return client.SetUserRole(ctx, ch.UserID, ch.To)
+	case ActionDeactivate:
+		return client.DeactivateUser(ctx, ch.UserID)
+	}
+	return fmt.Errorf("cannot apply %s", ch.Action)
+}
+
+// Sync reads source and brings the account in line with it. With dryRun it
+// only returns the plan and a nil report. Deactivations are only made when
+// opts.AllowDestructive is set.
+func Sync(ctx context.Context, client *fizzy.Client, source Source, opts Options, dryRun bool) (*Plan, *Report, error) {
+	plan, err := Diff(ctx, client, source, opts)
+	if err != nil || dryRun {
+		return plan, nil, err
+	}
+	report, err := Apply(ctx, client, plan, ApplyOptions{AllowDestructive: opts.AllowDestructive})
+	return plan, report, err
+}
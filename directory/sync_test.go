@@ -0,0 +1,184 @@
+package directory
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	fizzy "github.com/rogeriopvl/fizzy-go"
+)
+
+func newDirectoryServer(t *testing.T, calls *[]string) *httptest.Server {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /test-account/users", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode([]fizzy.User{
+			{ID: "user-1", Email: "owner@example.com", Name: "Owner", Role: "owner", Active: true},
+			{ID: "user-2", Email: "ana@example.com", Name: "Ana", Role: "member", Active: true},
+			{ID: "user-3", Email: "bob@example.com", Name: "Bob", Role: "admin", Active: true},
+			{ID: "user-4", Email: "bot@example.com", Name: "Bot", Role: "member", Active: true},
+			{ID: "user-5", Email: "gone@example.com", Name: "Gone", Role: "member", Active: false},
+		})
+	})
+	mux.HandleFunc("GET /my/identity", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(fizzy.GetMyIdentityResponse{Accounts: []fizzy.Account{
+			{ID: "acc-1", Slug: "/test-account", User: fizzy.User{ID: "user-4"}},
+		}})
+	})
+	mux.HandleFunc("GET /test-account/account/join_code", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(fizzy.JoinCode{Code: "abc", URL: "https://app.fizzy.do/join/abc"})
+	})
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]map[string]string
+		json.NewDecoder(r.Body).Decode(&body)
+		*calls = append(*calls, strings.TrimSpace(r.Method+" "+strings.TrimPrefix(r.URL.Path, "/test-account")+" "+body["user"]["name"]+body["user"]["role"]))
+		if r.URL.Path == "/test-account/users/user-3/role" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return server
+}
+
+var testPeople = People{
+	{Email: "ANA@example.com", Name: "Ana Silva", Role: "admin"},
+	{Email: "bob@example.com", Role: "member"},
+	{Email: "owner@example.com", Role: "member"},
+	{Email: "cara@example.com", Name: "Cara"},
+}
+
+func TestDiff(t *testing.T) {
+	var calls []string
+	server := newDirectoryServer(t, &calls)
+	client, _ := fizzy.NewClient("/test-account", "test-token", fizzy.WithBaseURL(server.URL))
+
+	plan, err := Diff(context.Background(), client, testPeople, Options{DeactivateMissing: true, Protected: []string{"bot@example.com"}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := strings.Join([]string{
+		`~ rename ana@example.com: "Ana" -> "Ana Silva"`,
+		`~ change role of ana@example.com: member -> admin`,
+		`~ change role of bob@example.com: admin -> member`,
+		`+ invite cara@example.com via https://app.fizzy.do/join/abc (manual)`,
+	}, "\n") + "\n"
+	if plan.String() != expected {
+		t.Errorf("unexpected plan:\n%s", plan)
+	}
+	if len(calls) != 0 {
+		t.Errorf("expected Diff not to modify anything, got %v", calls)
+	}
+
+	t.Run("leaves missing users alone by default", func(t *testing.T) {
+		plan, err := Diff(context.Background(), client, People{{Email: "ana@example.com"}}, Options{})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !plan.Empty() {
+			t.Errorf("expected no changes, got:\n%s", plan)
+		}
+	})
+
+	t.Run("deactivates missing users but not the caller", func(t *testing.T) {
+		plan, err := Diff(context.Background(), client, People{{Email: "ana@example.com"}}, Options{DeactivateMissing: true})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		expected := "- deactivate bob@example.com\n"
+		if plan.String() != expected {
+			t.Errorf("unexpected plan:\n%s", plan)
+		}
+		if !plan.Destructive() {
+			t.Error("expected deactivations to be destructive")
+		}
+	})
+
+	t.Run("refuses an empty directory", func(t *testing.T) {
+		people, err := ReadCSV(strings.NewReader(""))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		_, err = Diff(context.Background(), client, people, Options{DeactivateMissing: true})
+		if !errors.Is(err, ErrEmptyDirectory) {
+			t.Errorf("expected ErrEmptyDirectory, got %v", err)
+		}
+	})
+}
+
+func TestSync(t *testing.T) {
+	t.Run("dry run changes nothing", func(t *testing.T) {
+		var calls []string
+		server := newDirectoryServer(t, &calls)
+		client, _ := fizzy.NewClient("/test-account", "test-token", fizzy.WithBaseURL(server.URL))
+
+		plan, report, err := Sync(context.Background(), client, testPeople, Options{}, true)
+
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if plan.Empty() || report != nil || len(calls) != 0 {
+			t.Errorf("expected a plan only, got report %v and calls %v", report, calls)
+		}
+	})
+
+	t.Run("applies changes and reports each", func(t *testing.T) {
+		var calls []string
+		server := newDirectoryServer(t, &calls)
+		client, _ := fizzy.NewClient("/test-account", "test-token", fizzy.WithBaseURL(server.URL))
+
+		_, report, err := Sync(context.Background(), client, testPeople, Options{}, false)
+
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		expected := "PUT /users/user-2 Ana Silva,PUT /users/user-2/role admin,PUT /users/user-3/role member"
+		if strings.Join(calls, ",") != expected {
+			t.Errorf("unexpected calls: %v", calls)
+		}
+
+		if len(report.Entries) != 4 {
+			t.Fatalf("expected 4 entries, got %d", len(report.Entries))
+		}
+		failed := report.Failed()
+		if len(failed) != 1 || failed[0].Change.Email != "bob@example.com" {
+			t.Errorf("expected bob's role change to fail, got %+v", failed)
+		}
+		if report.Entries[3].Applied || report.Entries[3].Err != nil {
+			t.Errorf("expected invite to be skipped, got %+v", report.Entries[3])
+		}
+
+		text := report.String()
+		if !strings.Contains(text, "skipped (manual)") || !strings.Contains(text, "failed: unexpected status code 403") {
+			t.Errorf("unexpected report:\n%s", text)
+		}
+	})
+	t.Run("refuses deactivations unless destructive changes are allowed", func(t *testing.T) {
+		var calls []string
+		server := newDirectoryServer(t, &calls)
+		client, _ := fizzy.NewClient("/test-account", "test-token", fizzy.WithBaseURL(server.URL))
+		people := People{{Email: "ana@example.com"}}
+
+		_, report, err := Sync(context.Background(), client, people, Options{DeactivateMissing: true}, false)
+		if !errors.Is(err, ErrDestructive) || report != nil || len(calls) != 0 {
+			t.Fatalf("expected ErrDestructive and no changes, got %v and calls %v", err, calls)
+		}
+
+		_, _, err = Sync(context.Background(), client, people, Options{DeactivateMissing: true, AllowDestructive: true}, false)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if strings.Join(calls, ",") != "DELETE /users/user-3" {
+			t.Errorf("unexpected calls: %v", calls)
+		}
+	})
+}
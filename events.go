@@ -0,0 +1,280 @@
+package fizzy
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"iter"
+	"net/http"
+	"slices"
+	"time"
+)
+
+// Event actions reported by the activity feed.
+const (
+	ActionCardPublished     = "card_published"
+	ActionCardClosed        = "card_closed"
+	ActionCardReopened      = "card_reopened"
+	ActionCardPostponed     = "card_postponed"
+	ActionCardAutoPostponed = "card_auto_postponed"
+	ActionCardTriaged       = "card_triaged"
+	ActionCardSentToTriage  = "card_sent_back_to_triage"
+	ActionCardBoardChanged  = "card_board_changed"
+	ActionCardAssigned      = "card_assigned"
+	ActionCardUnassigned    = "card_unassigned"
+	ActionCommentCreated    = "comment_created"
+)
+
+// Event is an entry in the activity feed. It is one of CardPublished,
+// CardClosed, CardReopened, CardPostponed, CardMoved, CardAssigned,
+// CardUnassigned, CommentCreated or, for actions this package does not know,
+// OtherEvent. Use a type switch to tell them apart:
+//
+//	switch e := event.(type) {
+//	case *fizzy.CardMoved:
+//		fmt.Println(e.Card.Title, "moved to", e.Column)
+//	}
+type Event interface {
+	Info() EventInfo
+	isEvent()
+}
+
+// EventInfo holds what every event has in common.
+type EventInfo struct {
+	ID        string        `json:"id"`
+	Action    string        `json:"action"`
+	CreatedAt string        `json:"created_at"`
+	Creator   User          `json:"creator"`
+	Board     Board         `json:"board"`
+	Card      CardReference `json:"card"`
+}
+
+func (e EventInfo) Info() EventInfo { return e }
+
+func (EventInfo) isEvent() {}
+
+// Time parses CreatedAt, returning the zero time if it is malformed.
+func (e EventInfo) Time() time.Time {
+	t, _ := time.Parse(time.RFC3339Nano, e.CreatedAt)
+	return t
+}
+
+type CardPublished struct{ EventInfo }
+
+type CardClosed struct{ EventInfo }
+
+type CardReopened struct{ EventInfo }
+
+type CardPostponed struct {
+	EventInfo
+	// Automatic is set when the board's auto-postpone period moved the card
+	// to Not Now.
+	Automatic bool
+}
+
+// CardMoved is a card placed in a column, sent back to triage (empty Column)
+// or moved between boards (FromBoard and ToBoard set).
+type CardMoved struct {
+	EventInfo
+	Column    string
+	FromBoard string
+	ToBoard   string
+}
+
+type CardAssigned struct {
+	EventInfo
+	AssigneeIDs []string
+}
+
+type CardUnassigned struct {
+	EventInfo
+	AssigneeIDs []string
+}
+
+type CommentCreated struct {
+	EventInfo
+	CommentID string
+}
+
+// OtherEvent is an action without a dedicated type. Particulars holds the
+// action-specific details as sent by the API.
+type OtherEvent struct {
+	EventInfo
+	Particulars json.RawMessage
+}
+
+// rawEvent is an event as sent on the wire.
+type rawEvent struct {
+	EventInfo
+	Comment     *struct{ ID string } `json:"comment"`
+	Particulars json.RawMessage      `json:"particulars"`
+}
+
+func (raw *rawEvent) event() Event {
+	var particulars struct {
+		Column      string   `json:"column"`
+		OldBoard    string   `json:"old_board"`
+		NewBoard    string   `json:"new_board"`
+		AssigneeIDs []string `json:"assignee_ids"`
+	}
+	if len(raw.Particulars) > 0 {
+		json.Unmarshal(raw.Particulars, &particulars)
+	}
+
+	info := raw.EventInfo
+	switch info.Action {
+	case ActionCardPublished:
+		return &CardPublished{info}
+	case ActionCardClosed:
+		return &CardClosed{info}
+	case ActionCardReopened:
+		return &CardReopened{info}
+	case ActionCardPostponed, ActionCardAutoPostponed:
+		return &CardPostponed{EventInfo: info, Automatic: info.Action == ActionCardAutoPostponed}
+	case ActionCardTriaged, ActionCardSentToTriage:
+		return &CardMoved{EventInfo: info, Column: particulars.Column}
+	case ActionCardBoardChanged:
+		return &CardMoved{EventInfo: info, FromBoard: particulars.OldBoard, ToBoard: particulars.NewBoard}
+	case ActionCardAssigned:
+		return &CardAssigned{EventInfo: info, AssigneeIDs: particulars.AssigneeIDs}
+	case ActionCardUnassigned:
+		return &CardUnassigned{EventInfo: info, AssigneeIDs: particulars.AssigneeIDs}
+	case ActionCommentCreated:
+		event := &CommentCreated{EventInfo: info}
+		if raw.Comment != nil {
+			event.CommentID = raw.Comment.ID
+		}
+		return event
+	}
+	return &OtherEvent{EventInfo: info, Particulars: raw.Particulars}
+}
+
+// DecodeEvent decodes a single event in the activity feed's format, such as
+// the body of a webhook delivery.
+func DecodeEvent(data []byte) (Event, error) {
+	var raw rawEvent
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("failed to decode event: %w", err)
+	}
+	if raw.Action == "" {
+		return nil, fmt.Errorf("event has no action")
+	}
+	return raw.event(), nil
+}
+
+// EventFilters narrows the activity feed. Empty fields match everything.
+type EventFilters struct {
+	BoardIDs   []string
+	CreatorIDs []string
+	Actions    []string
+	Since      time.Time
+	Until      time.Time
+}
+
+func (f EventFilters) match(info EventInfo) bool {
+	if len(f.BoardIDs) > 0 && !slices.Contains(f.BoardIDs, info.Board.ID) {
+		return false
+	}
+	if len(f.CreatorIDs) > 0 && !slices.Contains(f.CreatorIDs, info.Creator.ID) {
+		return false
+	}
+	if len(f.Actions) > 0 && !slices.Contains(f.Actions, info.Action) {
+		return false
+	}
+	if !f.Until.IsZero() && !info.Time().Before(f.Until) {
+		return false
+	}
+	return true
+}
+
+// Events iterates over the account's activity feed, newest first, fetching
+// pages as needed. Filtering on boards gives a board's activity. When Since
+// is set, events with a malformed time are skipped. Iteration stops at the
+// first error, which is yielded with a nil Event.
+//
+//	for event, err := range client.Events(ctx, fizzy.EventFilters{Since: lastWeek}) {
+//		if err != nil {
+//			return err
+//		}
+//		fmt.Println(event.Info().Action)
+//	}
+func (c *Client) Events(ctx context.Context, filters EventFilters) iter.Seq2[Event, error] {
+	return func(yield func(Event, error) bool) {
+		endpointURL := c.AccountBaseURL + "/events"
+
+		req, err := c.newRequest(ctx, http.MethodGet, endpointURL, nil)
+		if err != nil {
+			yield(nil, fmt.Errorf("failed to create get events request: %w", err))
+			return
+		}
+
+		q := req.URL.Query()
+		for _, id := range filters.BoardIDs {
+			q.Add("board_ids[]", id)
+		}
+		for _, id := range filters.CreatorIDs {
+			q.Add("creator_ids[]", id)
+		}
+		for _, action := range filters.Actions {
+			q.Add("actions[]", action)
+		}
+		if !filters.Since.IsZero() {
+			q.Set("since", filters.Since.UTC().Format(time.RFC3339))
+		}
+		if !filters.Until.IsZero() {
+			q.Set("until", filters.Until.UTC().Format(time.RFC3339))
+		}
+		req.URL.RawQuery = q.Encode()
+
+		for {
+			var page []rawEvent
+			header, _, err := c.doRequest(req, &page)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+
+			// Filters are applied here too in case the server ignores them.
+			for i := range page {
+				info := page[i].EventInfo
+				if !filters.Since.IsZero() {
+					t := info.Time()
+					if t.IsZero() {
+						continue // without a time it cannot be placed in the window
+					}
+					if t.Before(filters.Since) {
+						return // the feed is newest first, so nothing older matches
+					}
+				}
+				if !filters.match(info) {
+					continue
+				}
+				if !yield(page[i].event(), nil) {
+					return
+				}
+			}
+
+			req, err = c.nextPageRequest(req, header)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			if req == nil {
+				return
+			}
+		}
+	}
+}
+
+// GetEvents collects every event matching filters. Prefer Events for long
+// time windows.
+func (c *Client) GetEvents(ctx context.Context, filters EventFilters) ([]Event, error) {
+	events := []Event{}
+	for event, err := range c.Events(ctx, filters) {
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, event)
+	}
+	return events, nil
+}
@@ -0,0 +1,200 @@
+package fizzy
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+const eventsPage1 = `[
+	{"id": "e6", "action": "comment_created", "created_at": "2025-12-06T12:00:00Z", "creator": {"id": "user-1"}, "board": {"id": "board-1"}, "card": {"id": "card-1"}, "comment": {"id": "comment-1"}},
+	{"id": "e5", "action": "card_triaged", "created_at": "2025-12-06T11:00:00Z", "creator": {"id": "user-2"}, "board": {"id": "board-1"}, "particulars": {"column": "Doing"}},
+	{"id": "e4", "action": "card_assigned", "created_at": "2025-12-06T10:00:00Z", "creator": {"id": "user-1"}, "board": {"id": "board-2"}, "particulars": {"assignee_ids": ["user-3"]}}
+]`
+
+const eventsPage2 = `[
+	{"id": "e3", "action": "card_auto_postponed", "created_at": "2025-12-05T12:00:00Z", "board": {"id": "board-1"}},
+	{"id": "e2", "action": "card_board_changed", "created_at": "2025-12-04T12:00:00Z", "board": {"id": "board-1"}, "particulars": {"old_board": "Inbox", "new_board": "Product"}},
+	{"id": "e1", "action": "card_title_changed", "created_at": "2025-12-03T12:00:00Z", "board": {"id": "board-1"}, "particulars": {"old_title": "A"}}
+]`
+
+func newEventsServer(t *testing.T, pages *int) *httptest.Server {
+	t.Helper()
+
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/test-account/events" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		*pages++
+		if r.URL.Query().Get("page") == "2" {
+			fmt.Fprint(w, eventsPage2)
+			return
+		}
+		w.Header().Set("Link", fmt.Sprintf(`<%s/test-account/events?page=2>; rel="next"`, server.URL))
+		fmt.Fprint(w, eventsPage1)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestEvents(t *testing.T) {
+	t.Run("decodes every event type across pages", func(t *testing.T) {
+		pages := 0
+		server := newEventsServer(t, &pages)
+		client, _ := NewClient("/test-account", "test-token", WithBaseURL(server.URL))
+
+		events, err := client.GetEvents(context.Background(), EventFilters{})
+
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(events) != 6 {
+			t.Fatalf("expected 6 events, got %d", len(events))
+		}
+
+		if e, ok := events[0].(*CommentCreated); !ok || e.CommentID != "comment-1" {
+			t.Errorf("unexpected event: %#v", events[0])
+		}
+		if e, ok := events[1].(*CardMoved); !ok || e.Column != "Doing" {
+			t.Errorf("unexpected event: %#v", events[1])
+		}
+		if e, ok := events[2].(*CardAssigned); !ok || len(e.AssigneeIDs) != 1 || e.AssigneeIDs[0] != "user-3" {
+			t.Errorf("unexpected event: %#v", events[2])
+		}
+		if e, ok := events[3].(*CardPostponed); !ok || !e.Automatic {
+			t.Errorf("unexpected event: %#v", events[3])
+		}
+		if e, ok := events[4].(*CardMoved); !ok || e.FromBoard != "Inbox" || e.ToBoard != "Product" {
+			t.Errorf("unexpected event: %#v", events[4])
+		}
+		if e, ok := events[5].(*OtherEvent); !ok || e.Info().Action != "card_title_changed" || len(e.Particulars) == 0 {
+			t.Errorf("unexpected event: %#v", events[5])
+		}
+	})
+
+	t.Run("filters by board, creator and time window", func(t *testing.T) {
+		pages := 0
+		server := newEventsServer(t, &pages)
+		client, _ := NewClient("/test-account", "test-token", WithBaseURL(server.URL))
+
+		events, err := client.GetEvents(context.Background(), EventFilters{
+			BoardIDs:   []string{"board-1"},
+			CreatorIDs: []string{"user-1"},
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(events) != 1 || events[0].Info().ID != "e6" {
+			t.Errorf("unexpected events: %v", events)
+		}
+
+		events, err = client.GetEvents(context.Background(), EventFilters{
+			Since: time.Date(2025, 12, 6, 0, 0, 0, 0, time.UTC),
+			Until: time.Date(2025, 12, 6, 12, 0, 0, 0, time.UTC),
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(events) != 2 || events[0].Info().ID != "e5" || events[1].Info().ID != "e4" {
+			t.Errorf("unexpected events: %v", events)
+		}
+	})
+
+	t.Run("stops fetching when iteration stops", func(t *testing.T) {
+		pages := 0
+		server := newEventsServer(t, &pages)
+		client, _ := NewClient("/test-account", "test-token", WithBaseURL(server.URL))
+
+		for event, err := range client.Events(context.Background(), EventFilters{}) {
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if event.Info().ID == "e5" {
+				break
+			}
+		}
+		if pages != 1 {
+			t.Errorf("expected 1 page to be fetched, got %d", pages)
+		}
+	})
+
+	t.Run("stops at events older than Since", func(t *testing.T) {
+		pages := 0
+		server := newEventsServer(t, &pages)
+		client, _ := NewClient("/test-account", "test-token", WithBaseURL(server.URL))
+
+		events, err := client.GetEvents(context.Background(), EventFilters{Since: time.Date(2025, 12, 6, 10, 30, 0, 0, time.UTC)})
+
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(events) != 2 || pages != 1 {
+			t.Errorf("expected 2 events from 1 page, got %d from %d", len(events), pages)
+		}
+	})
+
+	t.Run("skips events with a malformed time instead of stopping", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			fmt.Fprint(w, `[
+				{"id": "e3", "action": "card_closed", "created_at": "2025-12-06T12:00:00Z"},
+				{"id": "e2", "action": "card_closed", "created_at": "yesterday"},
+				{"id": "e1", "action": "card_closed", "created_at": "2025-12-06T10:00:00Z"}
+			]`)
+		}))
+		defer server.Close()
+		client, _ := NewClient("/test-account", "test-token", WithBaseURL(server.URL))
+
+		events, err := client.GetEvents(context.Background(), EventFilters{Since: time.Date(2025, 12, 6, 0, 0, 0, 0, time.UTC)})
+
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(events) != 2 || events[0].Info().ID != "e3" || events[1].Info().ID != "e1" {
+			t.Errorf("unexpected events: %v", events)
+		}
+	})
+
+	t.Run("yields errors", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusInternalServerError)
+		}))
+		defer server.Close()
+		client, _ := NewClient("/test-account", "test-token", WithBaseURL(server.URL))
+
+		_, err := client.GetEvents(context.Background(), EventFilters{})
+
+		if err == nil {
+			t.Fatal("expected error, got nil")
+		}
+	})
+}
+
+func TestDecodeEvent(t *testing.T) {
+	t.Run("decodes a typed event", func(t *testing.T) {
+		event, err := DecodeEvent([]byte(`{"id": "e1", "action": "card_assigned", "card": {"id": "c1"}, "particulars": {"assignee_ids": ["u1"]}}`))
+
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		assigned, ok := event.(*CardAssigned)
+		if !ok {
+			t.Fatalf("expected *CardAssigned, got %T", event)
+		}
+		if assigned.Card.ID != "c1" || len(assigned.AssigneeIDs) != 1 {
+			t.Errorf("unexpected event: %+v", assigned)
+		}
+	})
+
+	t.Run("returns error on invalid payload", func(t *testing.T) {
+		if _, err := DecodeEvent([]byte(`{"id": "e1"}`)); err == nil {
+			t.Error("expected error for missing action, got nil")
+		}
+		if _, err := DecodeEvent([]byte(`not json`)); err == nil {
+			t.Error("expected error for invalid JSON, got nil")
+		}
+	})
+}
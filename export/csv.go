@@ -0,0 +1,66 @@
+package export
+
+import (
+	"encoding/csv"
+	"io"
+	"strings"
+
+	fizzy "github.com/rogeriopvl/fizzy-go"
+)
+
+// CSVWriter writes cards as CSV rows, preceded by a header row.
+type CSVWriter struct {
+	w             *csv.Writer
+	fields        []Field
+	headerWritten bool
+}
+
+// NewCSVWriter returns a writer emitting the given fields, or DefaultFields
+// when none are given.
+func NewCSVWriter(w io.Writer, fields ...Field) *CSVWriter {
+	return &CSVWriter{w: csv.NewWriter(w), fields: fieldsOrDefault(fields)}
+}
+
+func (cw *CSVWriter) Write(card fizzy.Card) error {
+	if err := cw.writeHeader(); err != nil {
+		return err
+	}
+
+	row := make([]string, len(cw.fields))
+	for i, field := range cw.fields {
+		row[i] = escapeFormula(field.Value(card))
+	}
+	return cw.w.Write(row)
+}
+
+// escapeFormula prefixes cells that spreadsheets would run as formulas with
+// a quote, so a card title cannot inject one.
+func escapeFormula(cell string) string {
+	if cell != "" && strings.ContainsRune("=+-@\t\r", rune(cell[0])) {
+		return "'" + cell
+	}
+	return cell
+}
+
+// Flush writes any buffered rows, including the header if no card was
+// written, to the underlying writer.
+func (cw *CSVWriter) Flush() error {
+	if err := cw.writeHeader(); err != nil {
+		return err
+	}
+	cw.w.Flush()
+	return cw.w.Error()
+}
+
+func (cw *CSVWriter) writeHeader() error {
+	if cw.headerWritten {
+		return nil
+	}
+	cw.headerWritten = true
+
+	header := make([]string, len(cw.fields))
+	for i, field := range cw.fields {
+		header[i] = field.Header()
+	}
+	return cw.w.Write(header)
+}
@@ -0,0 +1,82 @@
+package export
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	fizzy "github.com/rogeriopvl/fizzy-go"
+)
+
+func TestCSVWriter(t *testing.T) {
+	t.Run("writes header and rows", func(t *testing.T) {
+		var b strings.Builder
+		w := NewCSVWriter(&b, FieldNumber, FieldTitle, FieldTags)
+
+		err := WriteCards(w, []fizzy.Card{
+			{Number: 1, Title: "Fix, login", Tags: []string{"bug"}},
+			{Number: 2, Title: "Docs"},
+		})
+
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		want := "Number,Title,Tags\n1,\"Fix, login\",bug\n2,Docs,\n"
+		if b.String() != want {
+			t.Errorf("unexpected CSV:\n%s", b.String())
+		}
+	})
+
+	t.Run("escapes cells that would run as formulas", func(t *testing.T) {
+		var b strings.Builder
+		w := NewCSVWriter(&b, FieldTitle, FieldTags)
+
+		err := WriteCards(w, []fizzy.Card{{Title: "=HYPERLINK(\"http://evil\")", Tags: []string{"-1", "ok"}}, {Title: "@home"}})
+
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		want := "Title,Tags\n\"'=HYPERLINK(\"\"http://evil\"\")\",\"'-1, ok\"\n'@home,\n"
+		if b.String() != want {
+			t.Errorf("unexpected CSV:\n%s", b.String())
+		}
+	})
+
+	t.Run("writes only the header for no cards", func(t *testing.T) {
+		var b strings.Builder
+		w := NewCSVWriter(&b, FieldNumber)
+
+		if err := w.Flush(); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if b.String() != "Number\n" {
+			t.Errorf("unexpected CSV: %q", b.String())
+		}
+	})
+}
+
+func TestExportCards(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("indexed_by") != "closed" {
+			t.Errorf("expected filters to be passed through, got %s", r.URL.RawQuery)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode([]fizzy.Card{{Number: 3, Title: "Done"}})
+	}))
+	defer server.Close()
+
+	client, _ := fizzy.NewClient("/test-account", "test-token", fizzy.WithBaseURL(server.URL))
+
+	var b strings.Builder
+	err := ExportCards(context.Background(), client, fizzy.CardFilters{IndexedBy: "closed"}, NewCSVWriter(&b, FieldNumber, FieldTitle, FieldClosed))
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if b.String() != "Number,Title,Closed\n3,Done,true\n" {
+		t.Errorf("unexpected CSV: %q", b.String())
+	}
+}
@@ -0,0 +1,166 @@
+// Package export writes card lists, such as the result of GetCards, as CSV
+// spreadsheets or Markdown for pasting into documents.
+//
+// Writers emit one card at a time, so large result sets can be streamed
+// without building the whole output in memory.
+package export
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	fizzy "github.com/rogeriopvl/fizzy-go"
+)
+
+// Field selects a card attribute to include in the output.
+type Field string
+
+const (
+	FieldNumber     Field = "number"
+	FieldTitle      Field = "title"
+	FieldBoard      Field = "board"
+	FieldColumn     Field = "column"
+	FieldTags       Field = "tags"
+	FieldAssignees  Field = "assignees"
+	FieldStatus     Field = "status"
+	FieldClosed     Field = "closed"
+	FieldGolden     Field = "golden"
+	FieldCreated    Field = "created"
+	FieldLastActive Field = "last_active"
+	FieldURL        Field = "url"
+)
+
+// DefaultFields is used when a writer is created without explicit fields.
+var DefaultFields = []Field{
+	FieldNumber,
+	FieldTitle,
+	FieldBoard,
+	FieldColumn,
+	FieldTags,
+	FieldAssignees,
+	FieldStatus,
+	FieldClosed,
+	FieldGolden,
+	FieldCreated,
+	FieldLastActive,
+	FieldURL,
+}
+
+var fieldHeaders = map[Field]string{
+	FieldNumber:     "Number",
+	FieldTitle:      "Title",
+	FieldBoard:      "Board",
+	FieldColumn:     "Column",
+	FieldTags:       "Tags",
+	FieldAssignees:  "Assignees",
+	FieldStatus:     "Status",
+	FieldClosed:     "Closed",
+	FieldGolden:     "Golden",
+	FieldCreated:    "Created",
+	FieldLastActive: "Last Active",
+	FieldURL:        "URL",
+}
+
+// ParseFields parses a comma-separated field list such as
+// "number,title,tags", as typically taken from a command line flag.
+func ParseFields(list string) ([]Field, error) {
+	var fields []Field
+	for _, name := range strings.Split(list, ",") {
+		field := Field(strings.TrimSpace(name))
+		if _, ok := fieldHeaders[field]; !ok {
+			return nil, fmt.Errorf("unknown field %q", name)
+		}
+		fields = append(fields, field)
+	}
+	return fields, nil
+}
+
+// Header returns the human readable column header for f.
+func (f Field) Header() string {
+	if h, ok := fieldHeaders[f]; ok {
+		return h
+	}
+	return string(f)
+}
+
+// Value returns the text of field f for card.
+func (f Field) Value(card fizzy.Card) string {
+	switch f {
+	case FieldNumber:
+		return strconv.Itoa(card.Number)
+	case FieldTitle:
+		return card.Title
+	case FieldBoard:
+		return card.Board.Name
+	case FieldColumn:
+		if card.Column != nil {
+			return card.Column.Name
+		}
+		return ""
+	case FieldTags:
+		return strings.Join(card.Tags, ", ")
+	case FieldAssignees:
+		names := make([]string, 0, len(card.Assignees))
+		for _, u := range card.Assignees {
+			names = append(names, u.Name)
+		}
+		return strings.Join(names, ", ")
+	case FieldStatus:
+		return card.Status
+	case FieldClosed:
+		return strconv.FormatBool(card.Closed)
+	case FieldGolden:
+		return strconv.FormatBool(card.Golden)
+	case FieldCreated:
+		return card.CreatedAt
+	case FieldLastActive:
+		return card.LastActiveAt
+	case FieldURL:
+		return card.URL
+	default:
+		return ""
+	}
+}
+
+// CardWriter is implemented by the CSV and Markdown writers.
+type CardWriter interface {
+	Write(card fizzy.Card) error
+	Flush() error
+}
+
+// WriteCards writes every card to w and flushes it.
+func WriteCards(w CardWriter, cards []fizzy.Card) error {
+	for _, card := range cards {
+		if err := w.Write(card); err != nil {
+			return err
+		}
+	}
+	return w.Flush()
+}
+
+// ExportCards fetches the cards matching filters and writes them to w page
+// by page, so the whole result never has to fit in memory. Listed cards
+// carry no closed flag, so cards from the closed index are marked closed.
+func ExportCards(ctx context.Context, client *fizzy.Client, filters fizzy.CardFilters, w CardWriter) error {
+	for card, err := range client.Cards(ctx, filters) {
+		if err != nil {
+			return err
+		}
+		if filters.IndexedBy == "closed" {
+			card.Closed = true
+		}
+		if err := w.Write(card); err != nil {
+			return err
+		}
+	}
+	return w.Flush()
+}
+
+func fieldsOrDefault(fields []Field) []Field {
+	if len(fields) == 0 {
+		return DefaultFields
+	}
+	return fields
+}
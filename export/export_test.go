@@ -0,0 +1,53 @@
+package export
+
+import (
+	"testing"
+
+	fizzy "github.com/rogeriopvl/fizzy-go"
+)
+
+func TestParseFields(t *testing.T) {
+	t.Run("parses known fields", func(t *testing.T) {
+		fields, err := ParseFields("number, title,tags")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(fields) != 3 || fields[2] != FieldTags {
+			t.Errorf("unexpected fields: %v", fields)
+		}
+	})
+
+	t.Run("rejects unknown fields", func(t *testing.T) {
+		if _, err := ParseFields("number,priority"); err == nil {
+			t.Fatal("expected error, got nil")
+		}
+	})
+}
+
+func TestFieldValue(t *testing.T) {
+	card := fizzy.Card{
+		Number:    7,
+		Tags:      []string{"bug", "ui"},
+		Assignees: []fizzy.User{{Name: "Ana"}, {Name: "Bob"}},
+		Column:    &fizzy.Column{Name: "Doing"},
+		Golden:    true,
+	}
+
+	tests := map[Field]string{
+		FieldNumber:    "7",
+		FieldTags:      "bug, ui",
+		FieldAssignees: "Ana, Bob",
+		FieldColumn:    "Doing",
+		FieldGolden:    "true",
+		FieldClosed:    "false",
+	}
+	for field, want := range tests {
+		if got := field.Value(card); got != want {
+			t.Errorf("%s: expected %q, got %q", field, want, got)
+		}
+	}
+
+	if got := FieldColumn.Value(fizzy.Card{}); got != "" {
+		t.Errorf("expected empty column for untriaged card, got %q", got)
+	}
+}
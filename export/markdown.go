@@ -0,0 +1,102 @@
+package export
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+
+	fizzy "github.com/rogeriopvl/fizzy-go"
+)
+
+// MarkdownTableWriter writes cards as rows of a Markdown table.
+type MarkdownTableWriter struct {
+	w             *bufio.Writer
+	fields        []Field
+	headerWritten bool
+}
+
+// NewMarkdownTableWriter returns a writer emitting the given fields, or
+// DefaultFields when none are given.
+func NewMarkdownTableWriter(w io.Writer, fields ...Field) *MarkdownTableWriter {
+	return &MarkdownTableWriter{w: bufio.NewWriter(w), fields: fieldsOrDefault(fields)}
+}
+
+func (mw *MarkdownTableWriter) Write(card fizzy.Card) error {
+	mw.writeHeader()
+
+	cells := make([]string, len(mw.fields))
+	for i, field := range mw.fields {
+		value := escapeMarkdownCell(field.Value(card))
+		if field == FieldTitle && card.URL != "" {
+			value = fmt.Sprintf("[%s](%s)", escapeMarkdownText(value), card.URL)
+		}
+		cells[i] = value
+	}
+	_, err := mw.w.WriteString("| " + strings.Join(cells, " | ") + " |\n")
+	return err
+}
+
+func (mw *MarkdownTableWriter) Flush() error {
+	mw.writeHeader()
+	return mw.w.Flush()
+}
+
+func (mw *MarkdownTableWriter) writeHeader() {
+	if mw.headerWritten {
+		return
+	}
+	mw.headerWritten = true
+
+	headers := make([]string, len(mw.fields))
+	separators := make([]string, len(mw.fields))
+	for i, field := range mw.fields {
+		headers[i] = field.Header()
+		separators[i] = "---"
+	}
+	mw.w.WriteString("| " + strings.Join(headers, " | ") + " |\n")
+	mw.w.WriteString("| " + strings.Join(separators, " | ") + " |\n")
+}
+
+// MarkdownChecklistWriter writes cards as a Markdown task list, checking off
+// closed cards:
+//
+//	- [x] #12 [Fix login](https://app.fizzy.do/.../cards/12)
+type MarkdownChecklistWriter struct {
+	w *bufio.Writer
+}
+
+func NewMarkdownChecklistWriter(w io.Writer) *MarkdownChecklistWriter {
+	return &MarkdownChecklistWriter{w: bufio.NewWriter(w)}
+}
+
+func (mw *MarkdownChecklistWriter) Write(card fizzy.Card) error {
+	check := " "
+	if card.Closed {
+		check = "x"
+	}
+
+	title := escapeMarkdownText(card.Title)
+	if card.URL != "" {
+		title = fmt.Sprintf("[%s](%s)", title, card.URL)
+	}
+
+	_, err := fmt.Fprintf(mw.w, "- [%s] #%d %s\n", check, card.Number, title)
+	return err
+}
+
+func (mw *MarkdownChecklistWriter) Flush() error {
+	return mw.w.Flush()
+}
+
+var markdownCellReplacer = strings.NewReplacer("|", `\|`, "\r\n", " ", "\n", " ")
+
+func escapeMarkdownCell(s string) string {
+	return markdownCellReplacer.Replace(s)
+}
+
+var markdownTextReplacer = strings.NewReplacer("[", `\[`, "]", `\]`, "\r\n", " ", "\n", " ")
+
+func escapeMarkdownText(s string) string {
+	return markdownTextReplacer.Replace(s)
+}
@@ -0,0 +1,47 @@
+package export
+
+import (
+	"strings"
+	"testing"
+
+	fizzy "github.com/rogeriopvl/fizzy-go"
+)
+
+func TestMarkdownTableWriter(t *testing.T) {
+	var b strings.Builder
+	w := NewMarkdownTableWriter(&b, FieldNumber, FieldTitle)
+
+	err := WriteCards(w, []fizzy.Card{
+		{Number: 1, Title: "a | b", URL: "https://example.com/cards/1"},
+		{Number: 2, Title: "multi\nline"},
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := "| Number | Title |\n" +
+		"| --- | --- |\n" +
+		"| 1 | [a \\| b](https://example.com/cards/1) |\n" +
+		"| 2 | multi line |\n"
+	if b.String() != want {
+		t.Errorf("unexpected table:\n%s", b.String())
+	}
+}
+
+func TestMarkdownChecklistWriter(t *testing.T) {
+	var b strings.Builder
+	w := NewMarkdownChecklistWriter(&b)
+
+	err := WriteCards(w, []fizzy.Card{
+		{Number: 1, Title: "Ship [beta]", Closed: true, URL: "https://example.com/cards/1"},
+		{Number: 2, Title: "Write docs"},
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := "- [x] #1 [Ship \\[beta\\]](https://example.com/cards/1)\n- [ ] #2 Write docs\n"
+	if b.String() != want {
+		t.Errorf("unexpected checklist:\n%s", b.String())
+	}
+}
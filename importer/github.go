@@ -0,0 +1,88 @@
+package importer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	fizzy "github.com/rogeriopvl/fizzy-go"
+)
+
+// GitHubIssue is an issue as exported by
+//
+//	gh issue list --state all --json number,title,body,state,labels,assignees,comments,createdAt,updatedAt
+type GitHubIssue struct {
+	Number    int             `json:"number"`
+	Title     string          `json:"title"`
+	Body      string          `json:"body"`
+	State     string          `json:"state"`
+	Labels    []GitHubLabel   `json:"labels"`
+	Assignees []GitHubUser    `json:"assignees"`
+	Comments  []GitHubComment `json:"comments"`
+	CreatedAt string          `json:"createdAt"`
+	UpdatedAt string          `json:"updatedAt"`
+}
+
+type GitHubLabel struct {
+	Name string `json:"name"`
+}
+
+type GitHubUser struct {
+	Login string `json:"login"`
+	Name  string `json:"name"`
+}
+
+type GitHubComment struct {
+	Author    GitHubUser `json:"author"`
+	Body      string     `json:"body"`
+	CreatedAt string     `json:"createdAt"`
+}
+
+// ParseGitHubIssues decodes the JSON array written by "gh issue list --json".
+func ParseGitHubIssues(r io.Reader) ([]GitHubIssue, error) {
+	var issues []GitHubIssue
+	if err := json.NewDecoder(r).Decode(&issues); err != nil {
+		return nil, fmt.Errorf("failed to decode GitHub issues: %w", err)
+	}
+	return issues, nil
+}
+
+// ImportGitHubIssues creates a card per issue. Labels become tags, task list
+// items in the body become steps, assignees are matched to Fizzy users via
+// opts.Emails or their display name, comments are copied with their original
+// author noted, and closed issues are closed. Report.Cards is keyed by "#n".
+func ImportGitHubIssues(ctx context.Context, client *fizzy.Client, issues []GitHubIssue, opts IssueOptions) (*Report, error) {
+	converted := make([]issue, 0, len(issues))
+	for _, gh := range issues {
+		is := issue{
+			Key:       "#" + strconv.Itoa(gh.Number),
+			Title:     gh.Title,
+			Body:      gh.Body,
+			Closed:    strings.EqualFold(gh.State, "closed"),
+			CreatedAt: gh.CreatedAt,
+			UpdatedAt: gh.UpdatedAt,
+		}
+		for _, label := range gh.Labels {
+			is.Labels = append(is.Labels, label.Name)
+		}
+		for _, assignee := range gh.Assignees {
+			is.Assignees = append(is.Assignees, person{Login: assignee.Login, Name: assignee.Name})
+		}
+		for _, comment := range gh.Comments {
+			author := comment.Author.Name
+			if author == "" {
+				author = comment.Author.Login
+			}
+			is.Comments = append(is.Comments, issueComment{Author: author, CreatedAt: comment.CreatedAt, Body: comment.Body})
+		}
+		converted = append(converted, is)
+	}
+
+	if opts.BoardName == "" {
+		opts.BoardName = "GitHub issues"
+	}
+	return importIssues(ctx, client, converted, opts)
+}
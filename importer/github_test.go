@@ -0,0 +1,82 @@
+package importer
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	fizzy "github.com/rogeriopvl/fizzy-go"
+)
+
+func TestExtractTaskList(t *testing.T) {
+	body, items := extractTaskList("Intro\n- [x] Done thing\n  * [ ] Open thing\n- not a task")
+
+	if body != "Intro\n- not a task" {
+		t.Errorf("unexpected remaining body: %q", body)
+	}
+	if len(items) != 2 || !items[0].Completed || items[1].Completed || items[1].Content != "Open thing" {
+		t.Errorf("unexpected task items: %+v", items)
+	}
+}
+
+func TestImportGitHubIssues(t *testing.T) {
+	f, err := os.Open("testdata/gh-issues.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	issues, err := ParseGitHubIssues(f)
+	if err != nil {
+		t.Fatalf("unexpected error parsing issues: %v", err)
+	}
+
+	server := newFakeFizzy(t, []fizzy.User{{ID: "user-ana", Email: "ana@example.com"}})
+	defer server.Close()
+
+	client, _ := fizzy.NewClient("/test-account", "test-token", fizzy.WithBaseURL(server.URL))
+	report, err := ImportGitHubIssues(context.Background(), client, issues, IssueOptions{
+		BoardID: "board-1",
+		Emails:  map[string]string{"ana-l": "ana@example.com"},
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	created := server.callsMatching("POST /boards/board-1/cards")
+	if len(created) != 2 || len(server.callsMatching("POST /boards")) != len(created) {
+		t.Error("expected cards to be created on the existing board without creating a new one")
+	}
+	if report.Cards["#12"] != 101 || report.Cards["#13"] != 102 {
+		t.Errorf("unexpected card mapping: %v", report.Cards)
+	}
+
+	card := server.callsMatching("POST /boards/board-1/cards")[0]["card"].(map[string]any)
+	if card["description"] != "<p>Steps:</p><p>See logs.</p>" {
+		t.Errorf("expected task list to be removed from description, got %v", card["description"])
+	}
+
+	steps := server.callsMatching("POST /cards/101/steps")
+	if len(steps) != 2 || steps[0]["step"].(map[string]any)["completed"] != true {
+		t.Errorf("expected task list items as steps, got %v", steps)
+	}
+	if tags := server.callsMatching("POST /cards/101/taggings"); len(tags) != 2 {
+		t.Errorf("expected 2 tags, got %v", tags)
+	}
+
+	assignments := server.callsMatching("POST /cards/101/assignments")
+	if len(assignments) != 1 || assignments[0]["assignee_id"] != "user-ana" {
+		t.Errorf("expected login mapped through Emails, got %v", assignments)
+	}
+	if len(report.UnmatchedUsers) != 1 || report.UnmatchedUsers[0] != "Ghost" {
+		t.Errorf("unexpected unmatched users: %v", report.UnmatchedUsers)
+	}
+
+	if len(server.callsMatching("POST /cards/101/closure")) != 1 {
+		t.Error("expected closed issue to be closed")
+	}
+	if len(server.callsMatching("POST /cards/102/closure")) != 0 {
+		t.Error("expected open issue to stay open")
+	}
+}
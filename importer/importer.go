@@ -0,0 +1,197 @@
+// Package importer brings boards from other tools into Fizzy.
+//
+// Each importer reads the other tool's export format, creates cards through
+// the regular client calls and returns a Report mapping source items to the
+// Fizzy objects created for them.
+package importer
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"strconv"
+	"strings"
+
+	fizzy "github.com/rogeriopvl/fizzy-go"
+)
+
+// Report maps the items of an import source to what was created in Fizzy.
+type Report struct {
+	BoardID string
+
+	// Columns maps source list or status names to created column IDs.
+	Columns map[string]string
+
+	// Cards maps source card or issue IDs to created card numbers.
+	Cards map[string]int
+
+	// Tags lists the tag titles applied to imported cards.
+	Tags []string
+
+	// Users maps source members to the Fizzy user IDs they resolved to.
+	Users map[string]string
+
+	// UnmatchedUsers lists source members that resolved to no Fizzy user.
+	// Their assignments are dropped.
+	UnmatchedUsers []string
+
+	// Warnings collects items that were skipped or imported partially.
+	Warnings []string
+}
+
+func newReport(boardID string) *Report {
+	return &Report{
+		BoardID: boardID,
+		Columns: make(map[string]string),
+		Cards:   make(map[string]int),
+		Users:   make(map[string]string),
+	}
+}
+
+func (r *Report) addTag(title string) {
+	for _, t := range r.Tags {
+		if t == title {
+			return
+		}
+	}
+	r.Tags = append(r.Tags, title)
+}
+
+func (r *Report) warnf(format string, args ...any) {
+	r.Warnings = append(r.Warnings, fmt.Sprintf(format, args...))
+}
+
+// userDirectory resolves people from an import source to Fizzy users by
+// email address first and display name second.
+type userDirectory struct {
+	byEmail map[string]fizzy.User
+	byName  map[string]fizzy.User
+}
+
+func loadUserDirectory(ctx context.Context, client *fizzy.Client) (*userDirectory, error) {
+	users, err := client.GetUsers(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list users: %w", err)
+	}
+	return newUserDirectory(users), nil
+}
+
+func newUserDirectory(users []fizzy.User) *userDirectory {
+	d := &userDirectory{
+		byEmail: make(map[string]fizzy.User, len(users)),
+		byName:  make(map[string]fizzy.User, len(users)),
+	}
+	for _, u := range users {
+		if u.Email != "" {
+			d.byEmail[strings.ToLower(u.Email)] = u
+		}
+		if u.Name != "" {
+			d.byName[strings.ToLower(u.Name)] = u
+		}
+	}
+	return d
+}
+
+func (d *userDirectory) find(email, name string) (fizzy.User, bool) {
+	if email != "" {
+		if u, ok := d.byEmail[strings.ToLower(email)]; ok {
+			return u, true
+		}
+	}
+	if name != "" {
+		if u, ok := d.byName[strings.ToLower(name)]; ok {
+			return u, true
+		}
+	}
+	return fizzy.User{}, false
+}
+
+// createTargetBoard returns a client scoped to boardID, creating a board
+// named name first when boardID is empty.
+func createTargetBoard(ctx context.Context, client *fizzy.Client, boardID, name string) (*fizzy.Client, string, error) {
+	if boardID == "" {
+		id, err := client.CreateBoardAndGetID(ctx, fizzy.CreateBoardPayload{Name: name, AllAccess: true})
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to create board %q: %w", name, err)
+		}
+		boardID = id
+	}
+	return client.ForBoard(boardID), boardID, nil
+}
+
+// textToHTML turns a plain text or Markdown body into simple rich text,
+// escaping it and keeping paragraph and line breaks.
+func textToHTML(text string) string {
+	text = strings.TrimSpace(strings.ReplaceAll(text, "\r\n", "\n"))
+	if text == "" {
+		return ""
+	}
+
+	var b strings.Builder
+	for _, paragraph := range strings.Split(text, "\n\n") {
+		lines := strings.Split(strings.TrimSpace(paragraph), "\n")
+		for i, line := range lines {
+			lines[i] = html.EscapeString(line)
+		}
+		b.WriteString("<p>" + strings.Join(lines, "<br>") + "</p>")
+	}
+	return b.String()
+}
+
+// attributedComment prefixes an imported comment with its original author
+// and date, since comments are created as the token's user.
+func attributedComment(author, date, text string) string {
+	header := "Originally posted"
+	if author != "" {
+		header += " by " + author
+	}
+	if date != "" {
+		header += " on " + date
+	}
+	return "<p><em>" + html.EscapeString(header) + "</em></p>" + textToHTML(text)
+}
+
+// Fizzy column colors as RGB, used to pick the nearest palette entry for
+// colors coming from other tools.
+var colorRGB = map[fizzy.Color][3]int{
+	fizzy.ColorBlue:   {0x3b, 0x82, 0xf6},
+	fizzy.ColorGray:   {0x8b, 0x8b, 0x8b},
+	fizzy.ColorTan:    {0xc8, 0xa9, 0x7e},
+	fizzy.ColorYellow: {0xf5, 0xc5, 0x18},
+	fizzy.ColorLime:   {0x8c, 0xd1, 0x3c},
+	fizzy.ColorAqua:   {0x2d, 0xc5, 0xc5},
+	fizzy.ColorViolet: {0x8b, 0x6c, 0xe6},
+	fizzy.ColorPurple: {0xa8, 0x4b, 0xc9},
+	fizzy.ColorPink:   {0xe9, 0x5f, 0xa0},
+}
+
+// NearestColor returns the Fizzy column color closest to a "#rrggbb" hex
+// value. ok is false when hex cannot be parsed.
+func NearestColor(hex string) (color fizzy.Color, ok bool) {
+	rgb, ok := parseHex(hex)
+	if !ok {
+		return "", false
+	}
+
+	best := -1
+	for _, c := range fizzy.AllColors() {
+		ref := colorRGB[c]
+		dr, dg, db := rgb[0]-ref[0], rgb[1]-ref[1], rgb[2]-ref[2]
+		if d := dr*dr + dg*dg + db*db; best < 0 || d < best {
+			best, color = d, c
+		}
+	}
+	return color, true
+}
+
+func parseHex(hex string) ([3]int, bool) {
+	hex = strings.TrimPrefix(hex, "#")
+	if len(hex) != 6 {
+		return [3]int{}, false
+	}
+	v, err := strconv.ParseUint(hex, 16, 32)
+	if err != nil {
+		return [3]int{}, false
+	}
+	return [3]int{int(v >> 16 & 0xff), int(v >> 8 & 0xff), int(v & 0xff)}, true
+}
@@ -0,0 +1,130 @@
+package importer
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	fizzy "github.com/rogeriopvl/fizzy-go"
+)
+
+// fakeFizzy is a minimal Fizzy API that hands out sequential IDs for created
+// resources and records every mutating call with its JSON body.
+type fakeFizzy struct {
+	*httptest.Server
+	mu      sync.Mutex
+	calls   []string
+	bodies  []map[string]any
+	columns int
+	cards   int
+}
+
+func newFakeFizzy(t *testing.T, users []fizzy.User) *fakeFizzy {
+	t.Helper()
+
+	f := &fakeFizzy{cards: 100}
+	f.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path := strings.TrimPrefix(r.URL.Path, "/test-account")
+
+		if r.Method == http.MethodGet {
+			w.Header().Set("Content-Type", "application/json")
+			if path == "/users" {
+				json.NewEncoder(w).Encode(users)
+			} else {
+				json.NewEncoder(w).Encode([]any{})
+			}
+			return
+		}
+
+		var body map[string]any
+		json.NewDecoder(r.Body).Decode(&body)
+
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		f.calls = append(f.calls, r.Method+" "+path)
+		f.bodies = append(f.bodies, body)
+
+		switch {
+		case path == "/boards":
+			w.Header().Set("Location", "/test-account/boards/new-board.json")
+			w.WriteHeader(http.StatusCreated)
+		case strings.HasSuffix(path, "/columns"):
+			f.columns++
+			w.Header().Set("Location", fmt.Sprintf("/test-account/boards/new-board/columns/col-%d.json", f.columns))
+			w.WriteHeader(http.StatusCreated)
+		case strings.HasSuffix(path, "/cards"):
+			f.cards++
+			w.Header().Set("Location", fmt.Sprintf("/test-account/cards/%d", f.cards))
+			w.WriteHeader(http.StatusCreated)
+		case strings.HasSuffix(path, "/steps") || strings.HasSuffix(path, "/comments"):
+			w.WriteHeader(http.StatusCreated)
+		default:
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+
+	return f
+}
+
+func (f *fakeFizzy) callsMatching(prefix string) []map[string]any {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	var out []map[string]any
+	for i, call := range f.calls {
+		if strings.HasPrefix(call, prefix) {
+			out = append(out, f.bodies[i])
+		}
+	}
+	return out
+}
+
+func TestNearestColor(t *testing.T) {
+	tests := []struct {
+		hex  string
+		want fizzy.Color
+	}{
+		{"#61bd4f", fizzy.ColorLime},
+		{"#f2d600", fizzy.ColorYellow},
+		{"#ff78cb", fizzy.ColorPink},
+		{"00c2e0", fizzy.ColorAqua},
+	}
+
+	for _, tt := range tests {
+		got, ok := NearestColor(tt.hex)
+		if !ok || got != tt.want {
+			t.Errorf("NearestColor(%q) = %q, %v; want %q", tt.hex, got, ok, tt.want)
+		}
+	}
+
+	if _, ok := NearestColor("not-a-color"); ok {
+		t.Error("expected invalid hex to be rejected")
+	}
+}
+
+func TestTextToHTML(t *testing.T) {
+	got := textToHTML("a <b>\nline\n\nnext")
+	want := "<p>a &lt;b&gt;<br>line</p><p>next</p>"
+	if got != want {
+		t.Errorf("textToHTML() = %q, want %q", got, want)
+	}
+}
+
+func TestUserDirectory(t *testing.T) {
+	d := newUserDirectory([]fizzy.User{
+		{ID: "u1", Email: "Ana@Example.com", Name: "Ana Lima"},
+	})
+
+	if u, ok := d.find("ana@example.com", ""); !ok || u.ID != "u1" {
+		t.Errorf("expected case-insensitive email match, got %+v", u)
+	}
+	if u, ok := d.find("", "ana lima"); !ok || u.ID != "u1" {
+		t.Errorf("expected name match, got %+v", u)
+	}
+	if _, ok := d.find("bob@example.com", "Bob"); ok {
+		t.Error("expected no match for unknown user")
+	}
+}
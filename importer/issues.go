@@ -0,0 +1,182 @@
+package importer
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	fizzy "github.com/rogeriopvl/fizzy-go"
+	"github.com/rogeriopvl/fizzy-go/internal/shared"
+)
+
+// issue is the tracker-neutral form GitHub and Jira issues are converted to
+// before being created as cards.
+type issue struct {
+	Key       string
+	Title     string
+	Body      string
+	Labels    []string
+	Assignees []person
+	Comments  []issueComment
+	Closed    bool
+	CreatedAt string
+	UpdatedAt string
+}
+
+type person struct {
+	Login string
+	Email string
+	Name  string
+}
+
+func (p person) String() string {
+	switch {
+	case p.Name != "":
+		return p.Name
+	case p.Login != "":
+		return p.Login
+	default:
+		return p.Email
+	}
+}
+
+type issueComment struct {
+	Author    string
+	CreatedAt string
+	Body      string
+}
+
+// IssueOptions configures the GitHub and Jira importers.
+type IssueOptions struct {
+	// BoardID imports into an existing board. When empty, a new board named
+	// BoardName is created.
+	BoardID   string
+	BoardName string
+
+	// Emails maps source logins or display names to email addresses, for
+	// sources that do not export emails (GitHub never does).
+	Emails map[string]string
+}
+
+var taskListItem = regexp.MustCompile(`^\s*[-*+]\s+\[([ xX])\]\s+(.+?)\s*$`)
+
+type taskItem struct {
+	Content   string
+	Completed bool
+}
+
+// extractTaskList splits Markdown task list items ("- [ ] foo") out of body,
+// returning the remaining text and the items in order.
+func extractTaskList(body string) (string, []taskItem) {
+	var rest []string
+	var items []taskItem
+
+	for _, line := range strings.Split(strings.ReplaceAll(body, "\r\n", "\n"), "\n") {
+		if m := taskListItem.FindStringSubmatch(line); m != nil {
+			items = append(items, taskItem{Content: m[2], Completed: m[1] != " "})
+			continue
+		}
+		rest = append(rest, line)
+	}
+
+	return strings.Join(rest, "\n"), items
+}
+
+func importIssues(ctx context.Context, client *fizzy.Client, issues []issue, opts IssueOptions) (*Report, error) {
+	users, err := loadUserDirectory(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+
+	boardClient, boardID, err := createTargetBoard(ctx, client, opts.BoardID, opts.BoardName)
+	if err != nil {
+		return nil, err
+	}
+	report := newReport(boardID)
+
+	for _, is := range issues {
+		description, tasks := extractTaskList(is.Body)
+
+		number, err := boardClient.CreateCardAndGetNumber(ctx, fizzy.CreateCardPayload{
+			Title:        is.Title,
+			Description:  textToHTML(description),
+			CreatedAt:    is.CreatedAt,
+			LastActiveAt: is.UpdatedAt,
+		})
+		if err != nil {
+			return report, fmt.Errorf("failed to create card for %s: %w", is.Key, err)
+		}
+		report.Cards[is.Key] = number
+
+		if err := importIssueDetails(ctx, client, number, is, tasks, users, opts, report); err != nil {
+			return report, fmt.Errorf("failed to import %s: %w", is.Key, err)
+		}
+	}
+
+	return report, nil
+}
+
+func importIssueDetails(ctx context.Context, client *fizzy.Client, number int, is issue, tasks []taskItem, users *userDirectory, opts IssueOptions, report *Report) error {
+	tagged := make(map[string]bool, len(is.Labels))
+	for _, label := range is.Labels {
+		if tagged[shared.TagKey(label)] {
+			continue
+		}
+		tagged[shared.TagKey(label)] = true
+		if err := client.TagCard(ctx, number, label); err != nil {
+			return err
+		}
+		report.addTag(label)
+	}
+
+	assigned := make(map[string]bool, len(is.Assignees))
+	for _, assignee := range is.Assignees {
+		email := assignee.Email
+		if email == "" {
+			email = opts.Emails[assignee.Login]
+		}
+		if email == "" {
+			email = opts.Emails[assignee.Name]
+		}
+
+		u, ok := users.find(email, assignee.Name)
+		if !ok {
+			if _, seen := report.Users[assignee.String()]; !seen && !slices.Contains(report.UnmatchedUsers, assignee.String()) {
+				report.UnmatchedUsers = append(report.UnmatchedUsers, assignee.String())
+			}
+			continue
+		}
+		report.Users[assignee.String()] = u.ID
+		if assigned[u.ID] {
+			continue
+		}
+		assigned[u.ID] = true
+
+		if err := client.AssignCard(ctx, number, u.ID); err != nil {
+			return err
+		}
+	}
+
+	for _, task := range tasks {
+		if _, err := client.CreateCardStep(ctx, number, task.Content, task.Completed); err != nil {
+			return err
+		}
+	}
+
+	for _, comment := range is.Comments {
+		body := attributedComment(comment.Author, comment.CreatedAt, comment.Body)
+		if _, err := client.CreateCardCommentAt(ctx, number, body, comment.CreatedAt); err != nil {
+			return err
+		}
+	}
+
+	if is.Closed {
+		if err := client.CloseCard(ctx, number); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,149 @@
+package importer
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"time"
+
+	fizzy "github.com/rogeriopvl/fizzy-go"
+)
+
+// jiraTimeLayouts are the date formats found in Jira CSV exports, which
+// follow the exporting user's locale settings.
+var jiraTimeLayouts = []string{
+	"02/Jan/06 3:04 PM",
+	"02/Jan/06 15:04",
+	"2006-01-02 15:04",
+	"2006-01-02 15:04:05",
+	time.RFC3339,
+}
+
+// JiraOptions configures ImportJiraCSV.
+type JiraOptions struct {
+	IssueOptions
+
+	// DoneStatuses are the statuses whose issues are imported closed.
+	// Defaults to Done, Closed and Resolved. Issues with a resolution date
+	// are always closed.
+	DoneStatuses []string
+}
+
+// ImportJiraCSV creates a card per row of a Jira CSV export. Labels become
+// tags, task list items in the description become steps, assignees are
+// matched to Fizzy users by email or display name, comments are copied with
+// their original author noted, and done or resolved issues are closed.
+// Report.Cards is keyed by issue key.
+func ImportJiraCSV(ctx context.Context, client *fizzy.Client, r io.Reader, opts JiraOptions) (*Report, error) {
+	issues, err := parseJiraCSV(r, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.BoardName == "" {
+		opts.BoardName = "Jira issues"
+	}
+	return importIssues(ctx, client, issues, opts.IssueOptions)
+}
+
+func parseJiraCSV(r io.Reader, opts JiraOptions) ([]issue, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read Jira CSV header: %w", err)
+	}
+
+	// Jira repeats columns such as Labels and Comment once per value.
+	columns := make(map[string][]int)
+	for i, name := range header {
+		key := strings.ToLower(strings.TrimSpace(name))
+		columns[key] = append(columns[key], i)
+	}
+
+	done := opts.DoneStatuses
+	if len(done) == 0 {
+		done = []string{"Done", "Closed", "Resolved"}
+	}
+
+	var issues []issue
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read Jira CSV: %w", err)
+		}
+
+		field := func(name string) string {
+			values := fieldValues(record, columns[name])
+			if len(values) == 0 {
+				return ""
+			}
+			return values[0]
+		}
+
+		is := issue{
+			Key:       field("issue key"),
+			Title:     field("summary"),
+			Body:      field("description"),
+			Labels:    fieldValues(record, columns["labels"]),
+			CreatedAt: jiraTime(field("created")),
+			UpdatedAt: jiraTime(field("updated")),
+			Closed:    field("resolved") != "" || slices.ContainsFunc(done, func(status string) bool { return strings.EqualFold(status, field("status")) }),
+		}
+		if assignee := field("assignee"); assignee != "" {
+			if strings.Contains(assignee, "@") {
+				is.Assignees = append(is.Assignees, person{Email: assignee})
+			} else {
+				is.Assignees = append(is.Assignees, person{Name: assignee})
+			}
+		}
+		for _, raw := range fieldValues(record, columns["comment"]) {
+			is.Comments = append(is.Comments, parseJiraComment(raw))
+		}
+
+		issues = append(issues, is)
+	}
+
+	return issues, nil
+}
+
+func fieldValues(record []string, indexes []int) []string {
+	var values []string
+	for _, i := range indexes {
+		if i < len(record) {
+			if v := strings.TrimSpace(record[i]); v != "" {
+				values = append(values, v)
+			}
+		}
+	}
+	return values
+}
+
+// parseJiraComment splits Jira's "date;author;body" comment cells.
+func parseJiraComment(raw string) issueComment {
+	parts := strings.SplitN(raw, ";", 3)
+	if len(parts) != 3 {
+		return issueComment{Body: raw}
+	}
+	created := jiraTime(parts[0])
+	if created == "" {
+		return issueComment{Body: raw}
+	}
+	return issueComment{CreatedAt: created, Author: parts[1], Body: parts[2]}
+}
+
+func jiraTime(value string) string {
+	for _, layout := range jiraTimeLayouts {
+		if t, err := time.Parse(layout, strings.TrimSpace(value)); err == nil {
+			return t.UTC().Format(time.RFC3339)
+		}
+	}
+	return ""
+}
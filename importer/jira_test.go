@@ -0,0 +1,89 @@
+package importer
+
+import (
+	"context"
+	"os"
+	"strings"
+	"testing"
+
+	fizzy "github.com/rogeriopvl/fizzy-go"
+)
+
+func TestParseJiraCSV(t *testing.T) {
+	f, err := os.Open("testdata/jira.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	issues, err := parseJiraCSV(f, JiraOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(issues) != 2 {
+		t.Fatalf("expected 2 issues, got %d", len(issues))
+	}
+
+	first := issues[0]
+	if first.Key != "OPS-1" || first.Closed {
+		t.Errorf("unexpected first issue: %+v", first)
+	}
+	if len(first.Labels) != 2 || first.Labels[1] != "perf" {
+		t.Errorf("expected labels from repeated columns, got %v", first.Labels)
+	}
+	if first.CreatedAt != "2024-03-12T15:04:00Z" {
+		t.Errorf("unexpected created at: %s", first.CreatedAt)
+	}
+	if len(first.Comments) != 2 || first.Comments[0].Author != "Bob" || first.Comments[1].Body != "plain comment" {
+		t.Errorf("unexpected comments: %+v", first.Comments)
+	}
+	if len(first.Assignees) != 1 || first.Assignees[0].Email != "ana@example.com" {
+		t.Errorf("expected email assignee, got %+v", first.Assignees)
+	}
+
+	if !issues[1].Closed {
+		t.Error("expected Done issue to be closed")
+	}
+}
+
+func TestImportJiraCSV(t *testing.T) {
+	f, err := os.Open("testdata/jira.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	server := newFakeFizzy(t, []fizzy.User{{ID: "user-ana", Email: "ana@example.com"}})
+	defer server.Close()
+
+	client, _ := fizzy.NewClient("/test-account", "test-token", fizzy.WithBaseURL(server.URL))
+	report, err := ImportJiraCSV(context.Background(), client, f, JiraOptions{})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	board := server.callsMatching("POST /boards")[0]["board"].(map[string]any)
+	if board["name"] != "Jira issues" {
+		t.Errorf("expected default board name, got %v", board["name"])
+	}
+	if report.Cards["OPS-1"] != 101 {
+		t.Errorf("unexpected card mapping: %v", report.Cards)
+	}
+
+	comments := server.callsMatching("POST /cards/101/comments")
+	body := comments[0]["comment"].(map[string]any)["body"].(string)
+	if !strings.Contains(body, "Originally posted by Bob") {
+		t.Errorf("expected original author to be noted, got %q", body)
+	}
+
+	if len(server.callsMatching("POST /cards/101/steps")) != 1 {
+		t.Error("expected task list item in description to become a step")
+	}
+	if tags := server.callsMatching("POST /cards/102/taggings"); len(tags) != 1 {
+		t.Errorf("expected a label repeated across Labels columns to be tagged once, got %v", tags)
+	}
+	if len(server.callsMatching("POST /cards/102/closure")) != 1 {
+		t.Error("expected Done issue to be closed")
+	}
+}
@@ -0,0 +1,312 @@
+package importer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	fizzy "github.com/rogeriopvl/fizzy-go"
+	"github.com/rogeriopvl/fizzy-go/internal/shared"
+)
+
+// TrelloBoard is the subset of Trello's board JSON export used by the
+// importer.
+type TrelloBoard struct {
+	ID         string            `json:"id"`
+	Name       string            `json:"name"`
+	Lists      []TrelloList      `json:"lists"`
+	Cards      []TrelloCard      `json:"cards"`
+	Labels     []TrelloLabel     `json:"labels"`
+	Checklists []TrelloChecklist `json:"checklists"`
+	Actions    []TrelloAction    `json:"actions"`
+	Members    []TrelloMember    `json:"members"`
+}
+
+type TrelloList struct {
+	ID     string  `json:"id"`
+	Name   string  `json:"name"`
+	Closed bool    `json:"closed"`
+	Pos    float64 `json:"pos"`
+	Color  string  `json:"color"`
+}
+
+type TrelloCard struct {
+	ID               string   `json:"id"`
+	Name             string   `json:"name"`
+	Desc             string   `json:"desc"`
+	IDList           string   `json:"idList"`
+	IDLabels         []string `json:"idLabels"`
+	IDChecklists     []string `json:"idChecklists"`
+	IDMembers        []string `json:"idMembers"`
+	Closed           bool     `json:"closed"`
+	Pos              float64  `json:"pos"`
+	DateLastActivity string   `json:"dateLastActivity"`
+}
+
+type TrelloLabel struct {
+	ID    string `json:"id"`
+	Name  string `json:"name"`
+	Color string `json:"color"`
+}
+
+type TrelloChecklist struct {
+	ID         string            `json:"id"`
+	IDCard     string            `json:"idCard"`
+	Name       string            `json:"name"`
+	Pos        float64           `json:"pos"`
+	CheckItems []TrelloCheckItem `json:"checkItems"`
+}
+
+type TrelloCheckItem struct {
+	Name  string  `json:"name"`
+	State string  `json:"state"`
+	Pos   float64 `json:"pos"`
+}
+
+type TrelloAction struct {
+	Type string `json:"type"`
+	Date string `json:"date"`
+	Data struct {
+		Text string `json:"text"`
+		Card struct {
+			ID string `json:"id"`
+		} `json:"card"`
+	} `json:"data"`
+	MemberCreator TrelloMember `json:"memberCreator"`
+}
+
+type TrelloMember struct {
+	ID       string `json:"id"`
+	FullName string `json:"fullName"`
+	Username string `json:"username"`
+	Email    string `json:"email"`
+}
+
+// trelloColors maps Trello's named colors to their hex values.
+var trelloColors = map[string]string{
+	"green":  "#61bd4f",
+	"yellow": "#f2d600",
+	"orange": "#ff9f1a",
+	"red":    "#eb5a46",
+	"purple": "#c377e0",
+	"blue":   "#0079bf",
+	"sky":    "#00c2e0",
+	"lime":   "#51e898",
+	"pink":   "#ff78cb",
+	"black":  "#344563",
+}
+
+// TrelloOptions configures ImportTrello.
+type TrelloOptions struct {
+	// BoardID imports into an existing board. When empty, a new board named
+	// after the Trello board is created.
+	BoardID string
+
+	// SkipArchivedLists leaves out archived lists and the cards in them.
+	SkipArchivedLists bool
+}
+
+// ParseTrello decodes a Trello board JSON export.
+func ParseTrello(r io.Reader) (*TrelloBoard, error) {
+	var board TrelloBoard
+	if err := json.NewDecoder(r).Decode(&board); err != nil {
+		return nil, fmt.Errorf("failed to decode Trello export: %w", err)
+	}
+	return &board, nil
+}
+
+// ImportTrello recreates a Trello board in Fizzy. Lists become columns,
+// labels become tags, checklist items become steps, comments are copied with
+// their original author noted, members are matched to Fizzy users by email or
+// full name and assigned, and archived cards are closed.
+func ImportTrello(ctx context.Context, client *fizzy.Client, board *TrelloBoard, opts TrelloOptions) (*Report, error) {
+	users, err := loadUserDirectory(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+
+	boardClient, boardID, err := createTargetBoard(ctx, client, opts.BoardID, board.Name)
+	if err != nil {
+		return nil, err
+	}
+	report := newReport(boardID)
+
+	lists := append([]TrelloList(nil), board.Lists...)
+	sort.SliceStable(lists, func(i, j int) bool { return lists[i].Pos < lists[j].Pos })
+
+	columnIDs := make(map[string]string, len(lists))
+	for _, list := range lists {
+		if list.Closed && opts.SkipArchivedLists {
+			continue
+		}
+
+		payload := fizzy.CreateColumnPayload{Name: list.Name}
+		if hex, ok := trelloColors[baseTrelloColor(list.Color)]; ok {
+			if color, ok := NearestColor(hex); ok {
+				payload.Color = &color
+			}
+		}
+
+		id, err := boardClient.CreateColumnAndGetID(ctx, payload)
+		if err != nil {
+			return report, fmt.Errorf("failed to create column %q: %w", list.Name, err)
+		}
+		columnIDs[list.ID] = id
+		report.Columns[list.Name] = id
+	}
+
+	labels := make(map[string]string, len(board.Labels))
+	for _, label := range board.Labels {
+		title := label.Name
+		if title == "" {
+			title = label.Color
+		}
+		labels[label.ID] = title
+	}
+
+	members := make(map[string]string, len(board.Members))
+	for _, member := range board.Members {
+		if u, ok := users.find(member.Email, member.FullName); ok {
+			members[member.ID] = u.ID
+			report.Users[member.FullName] = u.ID
+		} else {
+			report.UnmatchedUsers = append(report.UnmatchedUsers, member.FullName)
+		}
+	}
+
+	checklists := make(map[string]TrelloChecklist, len(board.Checklists))
+	for _, checklist := range board.Checklists {
+		checklists[checklist.ID] = checklist
+	}
+
+	comments := make(map[string][]TrelloAction)
+	for _, action := range board.Actions {
+		if action.Type == "commentCard" {
+			comments[action.Data.Card.ID] = append(comments[action.Data.Card.ID], action)
+		}
+	}
+
+	cards := append([]TrelloCard(nil), board.Cards...)
+	sort.SliceStable(cards, func(i, j int) bool { return cards[i].Pos < cards[j].Pos })
+
+	for _, card := range cards {
+		columnID, ok := columnIDs[card.IDList]
+		if !ok && opts.SkipArchivedLists {
+			continue
+		}
+
+		number, err := boardClient.CreateCardAndGetNumber(ctx, fizzy.CreateCardPayload{
+			Title:        card.Name,
+			Description:  textToHTML(card.Desc),
+			CreatedAt:    trelloCreatedAt(card.ID),
+			LastActiveAt: card.DateLastActivity,
+		})
+		if err != nil {
+			return report, fmt.Errorf("failed to create card %q: %w", card.Name, err)
+		}
+		report.Cards[card.ID] = number
+
+		if err := importTrelloCardDetails(ctx, client, number, card, labels, members, checklists, comments[card.ID], report); err != nil {
+			return report, fmt.Errorf("failed to import card %q: %w", card.Name, err)
+		}
+
+		if columnID != "" {
+			if err := client.TriageCard(ctx, number, columnID); err != nil {
+				return report, fmt.Errorf("failed to move card %q to its column: %w", card.Name, err)
+			}
+		}
+		if card.Closed {
+			if err := client.CloseCard(ctx, number); err != nil {
+				return report, fmt.Errorf("failed to close archived card %q: %w", card.Name, err)
+			}
+		}
+	}
+
+	return report, nil
+}
+
+func importTrelloCardDetails(ctx context.Context, client *fizzy.Client, number int, card TrelloCard, labels, members map[string]string, checklists map[string]TrelloChecklist, comments []TrelloAction, report *Report) error {
+	tagged := make(map[string]bool, len(card.IDLabels))
+	for _, labelID := range card.IDLabels {
+		title, ok := labels[labelID]
+		if !ok {
+			report.warnf("card %q references unknown label %s", card.Name, labelID)
+			continue
+		}
+		if tagged[shared.TagKey(title)] {
+			continue
+		}
+		tagged[shared.TagKey(title)] = true
+		if err := client.TagCard(ctx, number, title); err != nil {
+			return err
+		}
+		report.addTag(title)
+	}
+
+	assigned := make(map[string]bool, len(card.IDMembers))
+	for _, memberID := range card.IDMembers {
+		userID, ok := members[memberID]
+		if !ok || assigned[userID] {
+			continue
+		}
+		assigned[userID] = true
+		if err := client.AssignCard(ctx, number, userID); err != nil {
+			return err
+		}
+	}
+
+	var cardChecklists []TrelloChecklist
+	for _, id := range card.IDChecklists {
+		if checklist, ok := checklists[id]; ok {
+			cardChecklists = append(cardChecklists, checklist)
+		}
+	}
+	sort.SliceStable(cardChecklists, func(i, j int) bool { return cardChecklists[i].Pos < cardChecklists[j].Pos })
+	for _, checklist := range cardChecklists {
+		items := append([]TrelloCheckItem(nil), checklist.CheckItems...)
+		sort.SliceStable(items, func(i, j int) bool { return items[i].Pos < items[j].Pos })
+		for _, item := range items {
+			content := item.Name
+			if len(cardChecklists) > 1 {
+				content = checklist.Name + ": " + content
+			}
+			if _, err := client.CreateCardStep(ctx, number, content, item.State == "complete"); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Trello lists actions newest first.
+	sort.SliceStable(comments, func(i, j int) bool { return comments[i].Date < comments[j].Date })
+	for _, comment := range comments {
+		body := attributedComment(comment.MemberCreator.FullName, comment.Date, comment.Data.Text)
+		if _, err := client.CreateCardCommentAt(ctx, number, body, comment.Date); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// baseTrelloColor strips Trello's "_dark" and "_light" shade suffixes.
+func baseTrelloColor(color string) string {
+	return strings.TrimSuffix(strings.TrimSuffix(color, "_dark"), "_light")
+}
+
+// trelloCreatedAt recovers a card's creation time from its ID, whose first
+// eight hex digits are a Unix timestamp.
+func trelloCreatedAt(id string) string {
+	if len(id) < 8 {
+		return ""
+	}
+	seconds, err := strconv.ParseInt(id[:8], 16, 64)
+	if err != nil {
+		return ""
+	}
+	return time.Unix(seconds, 0).UTC().Format(time.RFC3339)
+}
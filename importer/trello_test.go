@@ -0,0 +1,105 @@
+package importer
+
+import (
+	"context"
+	"os"
+	"strings"
+	"testing"
+
+	fizzy "github.com/rogeriopvl/fizzy-go"
+)
+
+func TestImportTrello(t *testing.T) {
+	f, err := os.Open("testdata/trello.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	board, err := ParseTrello(f)
+	if err != nil {
+		t.Fatalf("unexpected error parsing export: %v", err)
+	}
+
+	server := newFakeFizzy(t, []fizzy.User{{ID: "user-ana", Name: "Ana Lima"}})
+	defer server.Close()
+
+	client, _ := fizzy.NewClient("/test-account", "test-token", fizzy.WithBaseURL(server.URL))
+	report, err := ImportTrello(context.Background(), client, board, TrelloOptions{})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	t.Run("creates a board and columns in list order", func(t *testing.T) {
+		boards := server.callsMatching("POST /boards")
+		if name := boards[0]["board"].(map[string]any)["name"]; name != "Website" {
+			t.Errorf("expected board name 'Website', got %v", name)
+		}
+
+		columns := server.callsMatching("POST /boards/new-board/columns")
+		if len(columns) != 2 {
+			t.Fatalf("expected 2 columns, got %d", len(columns))
+		}
+		first := columns[0]["column"].(map[string]any)
+		if first["name"] != "To Do" || first["color"] != nil {
+			t.Errorf("expected uncolored 'To Do' first, got %v", first)
+		}
+		if color := columns[1]["column"].(map[string]any)["color"]; color != string(fizzy.ColorLime) {
+			t.Errorf("expected green list to map to lime, got %v", color)
+		}
+	})
+
+	t.Run("maps card contents", func(t *testing.T) {
+		cards := server.callsMatching("POST /boards/new-board/cards")
+		card := cards[0]["card"].(map[string]any)
+		if card["description"] != "<p>The header is broken.</p><p>See &lt;nav&gt;.</p>" {
+			t.Errorf("unexpected description: %v", card["description"])
+		}
+		if card["created_at"] != "2020-07-24T00:28:44Z" {
+			t.Errorf("expected creation time from Trello ID, got %v", card["created_at"])
+		}
+
+		tags := server.callsMatching("POST /cards/101/taggings")
+		if len(tags) != 2 || tags[1]["tag_title"] != "orange" {
+			t.Errorf("expected label tags with color fallback and duplicates skipped, got %v", tags)
+		}
+
+		steps := server.callsMatching("POST /cards/101/steps")
+		if len(steps) != 2 || steps[0]["step"].(map[string]any)["content"] != "Reproduce" {
+			t.Errorf("expected steps in checklist order, got %v", steps)
+		}
+
+		comments := server.callsMatching("POST /cards/101/comments")
+		if len(comments) != 2 || !strings.Contains(comments[0]["comment"].(map[string]any)["body"].(string), "Bob Unknown") {
+			t.Errorf("expected oldest comment first with author noted, got %v", comments)
+		}
+
+		assignments := server.callsMatching("POST /cards/101/assignments")
+		if len(assignments) != 1 || assignments[0]["assignee_id"] != "user-ana" {
+			t.Errorf("expected only matched member to be assigned, got %v", assignments)
+		}
+	})
+
+	t.Run("closes archived cards and triages into columns", func(t *testing.T) {
+		if len(server.callsMatching("POST /cards/102/closure")) != 1 {
+			t.Error("expected archived card to be closed")
+		}
+		triage := server.callsMatching("POST /cards/101/triage")
+		if len(triage) != 1 || triage[0]["column_id"] != "col-1" {
+			t.Errorf("expected card triaged into 'To Do', got %v", triage)
+		}
+	})
+
+	t.Run("reports the mapping", func(t *testing.T) {
+		if report.Cards["5f1a2b3c0000000000000001"] != 101 {
+			t.Errorf("unexpected card mapping: %v", report.Cards)
+		}
+		if len(report.UnmatchedUsers) != 1 || report.UnmatchedUsers[0] != "Bob Unknown" {
+			t.Errorf("unexpected unmatched users: %v", report.UnmatchedUsers)
+		}
+		if report.Columns["Done"] != "col-2" {
+			t.Errorf("unexpected column mapping: %v", report.Columns)
+		}
+	})
+}
@@ -0,0 +1,62 @@
+// Package shared holds helpers used by several of the module's packages.
+package shared
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+)
+
+// WriteFileAtomic writes data to a temporary file next to path and renames
+// it into place, so readers never see a partly written file.
+func WriteFileAtomic(path string, data []byte) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+"-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+// MatchName reports whether ref is the ID or, ignoring case, the name of
+// something.
+func MatchName(ref, id, name string) bool {
+	return ref == id || strings.EqualFold(ref, name)
+}
+
+// TagKey is how Fizzy compares tag titles: case-insensitively, ignoring a
+// leading "#". Tagging toggles, so a card must not be tagged twice with
+// titles sharing a key.
+func TagKey(title string) string {
+	return strings.ToLower(strings.TrimPrefix(strings.TrimSpace(title), "#"))
+}
+
+// ParseTemplate parses text as a Go template, so configs can be checked
+// before Render runs them.
+func ParseTemplate(text string) (*template.Template, error) {
+	return template.New("").Parse(text)
+}
+
+// Render executes text as a Go template with data.
+func Render(text string, data any) (string, error) {
+	tmpl, err := ParseTemplate(text)
+	if err != nil {
+		return "", err
+	}
+	var b bytes.Buffer
+	if err := tmpl.Execute(&b, data); err != nil {
+		return "", fmt.Errorf("failed to render %q: %w", text, err)
+	}
+	return b.String(), nil
+}
@@ -0,0 +1,60 @@
+package shared
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestWriteFileAtomic(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "state.json")
+
+	for _, data := range []string{"first", "second"} {
+		if err := WriteFileAtomic(path, []byte(data)); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	if data, _ := os.ReadFile(path); string(data) != "second" {
+		t.Errorf("expected the latest write, got %q", data)
+	}
+	if entries, _ := os.ReadDir(dir); len(entries) != 1 {
+		t.Errorf("expected no temporary files left, got %v", entries)
+	}
+}
+
+func TestMatchName(t *testing.T) {
+	if !MatchName("col-1", "col-1", "Doing") || !MatchName("doing", "col-1", "Doing") {
+		t.Error("expected ID and case-insensitive name to match")
+	}
+	if MatchName("Done", "col-1", "Doing") {
+		t.Error("expected a different name not to match")
+	}
+}
+
+func TestTagKey(t *testing.T) {
+	if TagKey(" #Bug") != TagKey("bug") {
+		t.Errorf("expected %q and %q to share a key", " #Bug", "bug")
+	}
+}
+
+func TestParseTemplate(t *testing.T) {
+	if _, err := ParseTemplate("Hello {{.Name}}"); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if _, err := ParseTemplate("Hello {{.Name"); err == nil {
+		t.Error("expected error for an unclosed action, got nil")
+	}
+}
+
+func TestRender(t *testing.T) {
+	got, err := Render("Hello {{.Name}}", map[string]string{"Name": "Ana"})
+	if err != nil || got != "Hello Ana" {
+		t.Errorf("unexpected render: %q, %v", got, err)
+	}
+
+	if _, err := Render("{{.Nope}}", struct{}{}); err == nil {
+		t.Error("expected error for a missing field, got nil")
+	}
+}
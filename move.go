@@ -0,0 +1,151 @@
+package fizzy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"html"
+	"net/http"
+)
+
+// MoveCard moves a card to another board, keeping its number, history and
+// comments. If targetColumnID is set the card is placed in that column of the
+// target board; otherwise it lands in the board's triage.
+func (c *Client) MoveCard(ctx context.Context, cardNumber int, targetBoardID string, targetColumnID string) error {
+	if err := c.moveCardToBoard(ctx, cardNumber, targetBoardID); err != nil {
+		return err
+	}
+
+	if targetColumnID == "" {
+		return nil
+	}
+	return c.TriageCard(ctx, cardNumber, targetColumnID)
+}
+
+func (c *Client) moveCardToBoard(ctx context.Context, cardNumber int, targetBoardID string) error {
+	endpointURL := fmt.Sprintf("%s/cards/%d/board", c.AccountBaseURL, cardNumber)
+
+	body := map[string]string{"board_id": targetBoardID}
+
+	req, err := c.newRequest(ctx, http.MethodPut, endpointURL, body)
+	if err != nil {
+		return fmt.Errorf("failed to create move card request: %w", err)
+	}
+
+	_, err = c.decodeResponse(req, nil, http.StatusNoContent)
+	return err
+}
+
+// MoveOrCopyCard moves a card with MoveCard and, if the API does not offer
+// the move (404 or 405), falls back to CopyCardToBoard. Other failures, such
+// as a permission denial, are returned without copying. It returns the card's number on
+// the target board, which only changes when the card was copied. Once the
+// card has moved, failing to place it in targetColumnID is returned as is,
+// along with the card's number.
+func (c *Client) MoveOrCopyCard(ctx context.Context, cardNumber int, targetBoardID string, targetColumnID string) (int, error) {
+	if err := c.moveCardToBoard(ctx, cardNumber, targetBoardID); err != nil {
+		var apiErr *APIError
+		if !errors.As(err, &apiErr) {
+			return 0, err
+		}
+		switch apiErr.StatusCode {
+		case http.StatusNotFound, http.StatusMethodNotAllowed:
+			return c.CopyCardToBoard(ctx, cardNumber, targetBoardID, targetColumnID)
+		}
+		return 0, err
+	}
+
+	if targetColumnID == "" {
+		return cardNumber, nil
+	}
+	return cardNumber, c.TriageCard(ctx, cardNumber, targetColumnID)
+}
+
+// CopyCardToBoard recreates a card on another board with its description,
+// tags, assignees, steps and comments, then closes the original with a
+// comment linking to the copy. A closed card's copy is closed too. Comments
+// keep their original timestamps and note their original author. The header image and reactions are not copied.
+// It returns the new card's number.
+func (c *Client) CopyCardToBoard(ctx context.Context, cardNumber int, targetBoardID string, targetColumnID string) (int, error) {
+	card, err := c.GetCard(ctx, cardNumber)
+	if err != nil {
+		return 0, err
+	}
+	comments, err := c.GetCardComments(ctx, cardNumber)
+	if err != nil {
+		return 0, err
+	}
+
+	number, err := c.ForBoard(targetBoardID).CreateCardAndGetNumber(ctx, CreateCardPayload{
+		Title:        card.Title,
+		Description:  card.DescriptionHTML,
+		CreatedAt:    card.CreatedAt,
+		LastActiveAt: card.LastActiveAt,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to create copy of card %d: %w", cardNumber, err)
+	}
+
+	for _, tag := range card.Tags {
+		if err := c.TagCard(ctx, number, tag); err != nil {
+			return number, err
+		}
+	}
+	for _, assignee := range card.Assignees {
+		if err := c.AssignCard(ctx, number, assignee.ID); err != nil {
+			return number, err
+		}
+	}
+	for _, step := range card.Steps {
+		if _, err := c.CreateCardStep(ctx, number, step.Content, step.Completed); err != nil {
+			return number, err
+		}
+	}
+	for _, comment := range comments {
+		body := "<p><em>Originally posted by " + html.EscapeString(comment.Creator.Name) + "</em></p>" + comment.Body.HTML
+		if _, err := c.CreateCardCommentAt(ctx, number, body, comment.CreatedAt); err != nil {
+			return number, err
+		}
+	}
+	if card.Golden {
+		if err := c.MarkCardGolden(ctx, number); err != nil {
+			return number, err
+		}
+	}
+	if targetColumnID != "" {
+		if err := c.TriageCard(ctx, number, targetColumnID); err != nil {
+			return number, err
+		}
+	}
+
+	copied, err := c.GetCard(ctx, number)
+	if err != nil {
+		return number, err
+	}
+	if _, err := c.CreateCardComment(ctx, number, cardLink("Copied from", card)); err != nil {
+		return number, err
+	}
+	if _, err := c.CreateCardComment(ctx, cardNumber, cardLink("Moved to", copied)); err != nil {
+		return number, err
+	}
+	if card.Closed {
+		if err := c.CloseCard(ctx, number); err != nil {
+			return number, err
+		}
+	} else if err := c.CloseCard(ctx, cardNumber); err != nil {
+		return number, err
+	}
+
+	return number, nil
+}
+
+func cardLink(prefix string, card *Card) string {
+	label := fmt.Sprintf("#%d %s", card.Number, card.Title)
+	if card.Board.Name != "" {
+		label += " on " + card.Board.Name
+	}
+	if card.URL == "" {
+		return "<p>" + html.EscapeString(prefix+" "+label) + "</p>"
+	}
+	return fmt.Sprintf(`<p>%s <a href="%s">%s</a></p>`, html.EscapeString(prefix), html.EscapeString(card.URL), html.EscapeString(label))
+}
@@ -0,0 +1,211 @@
+package fizzy
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestMoveCard(t *testing.T) {
+	t.Run("moves card and triages it", func(t *testing.T) {
+		var calls []string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var body map[string]string
+			json.NewDecoder(r.Body).Decode(&body)
+			calls = append(calls, r.Method+" "+r.URL.Path+" "+body["board_id"]+body["column_id"])
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		defer server.Close()
+
+		client, _ := NewClient("/test-account", "test-token", WithBaseURL(server.URL))
+		err := client.MoveCard(context.Background(), 42, "board-2", "col-1")
+
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		expected := "PUT /test-account/cards/42/board board-2,POST /test-account/cards/42/triage col-1"
+		if strings.Join(calls, ",") != expected {
+			t.Errorf("unexpected calls: %v", calls)
+		}
+	})
+
+	t.Run("returns error on failure", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusForbidden)
+		}))
+		defer server.Close()
+
+		client, _ := NewClient("/test-account", "test-token", WithBaseURL(server.URL))
+		err := client.MoveCard(context.Background(), 42, "board-2", "")
+
+		if err == nil {
+			t.Fatal("expected error, got nil")
+		}
+	})
+}
+
+func TestMoveOrCopyCard(t *testing.T) {
+	var calls []string
+	var bodies []map[string]map[string]any
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("PUT /test-account/cards/42/board", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+	mux.HandleFunc("GET /test-account/cards/42", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(Card{
+			Number:          42,
+			Title:           "Wrong board",
+			DescriptionHTML: "<p>Details</p>",
+			CreatedAt:       "2025-01-01T00:00:00Z",
+			Tags:            []string{"bug"},
+			Assignees:       []User{{ID: "user-1"}},
+			Steps:           []Step{{Content: "Reproduce", Completed: true}},
+			URL:             "https://app.fizzy.do/test-account/cards/42",
+		})
+	})
+	mux.HandleFunc("GET /test-account/cards/42/comments", func(w http.ResponseWriter, r *http.Request) {
+		comment := Comment{CreatedAt: "2025-01-02T00:00:00Z", Creator: User{Name: "Ana"}}
+		comment.Body.HTML = "<p>Seen it</p>"
+		json.NewEncoder(w).Encode([]Comment{comment})
+	})
+	mux.HandleFunc("GET /test-account/cards/43", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(Card{Number: 43, Title: "Wrong board", URL: "https://app.fizzy.do/test-account/cards/43"})
+	})
+	mux.HandleFunc("POST /test-account/boards/board-2/cards", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Location", "/test-account/cards/43")
+		w.WriteHeader(http.StatusCreated)
+	})
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]map[string]any
+		json.NewDecoder(r.Body).Decode(&body)
+		calls = append(calls, r.Method+" "+strings.TrimPrefix(r.URL.Path, "/test-account"))
+		bodies = append(bodies, body)
+		if strings.HasSuffix(r.URL.Path, "/comments") || strings.HasSuffix(r.URL.Path, "/steps") {
+			w.WriteHeader(http.StatusCreated)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	client, _ := NewClient("/test-account", "test-token", WithBaseURL(server.URL))
+	number, err := client.MoveOrCopyCard(context.Background(), 42, "board-2", "col-1")
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if number != 43 {
+		t.Errorf("expected new card 43, got %d", number)
+	}
+
+	expected := []string{
+		"POST /cards/43/taggings",
+		"POST /cards/43/assignments",
+		"POST /cards/43/steps",
+		"POST /cards/43/comments",
+		"POST /cards/43/triage",
+		"POST /cards/43/comments",
+		"POST /cards/42/comments",
+		"POST /cards/42/closure",
+	}
+	if strings.Join(calls, "\n") != strings.Join(expected, "\n") {
+		t.Errorf("unexpected calls:\n%s", strings.Join(calls, "\n"))
+	}
+
+	copied := bodies[3]["comment"]
+	if copied["created_at"] != "2025-01-02T00:00:00Z" || !strings.Contains(copied["body"].(string), "Ana") {
+		t.Errorf("unexpected copied comment: %v", copied)
+	}
+	link := bodies[6]["comment"]["body"].(string)
+	if !strings.Contains(link, "https://app.fizzy.do/test-account/cards/43") {
+		t.Errorf("expected link to the copy, got %s", link)
+	}
+}
+
+func TestMoveOrCopyCardTriageFailure(t *testing.T) {
+	var calls []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, r.Method+" "+strings.TrimPrefix(r.URL.Path, "/test-account"))
+		if strings.HasSuffix(r.URL.Path, "/triage") {
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client, _ := NewClient("/test-account", "test-token", WithBaseURL(server.URL))
+	number, err := client.MoveOrCopyCard(context.Background(), 42, "board-2", "bad-column")
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusUnprocessableEntity {
+		t.Errorf("expected the triage error, got %v", err)
+	}
+	if number != 42 {
+		t.Errorf("expected the moved card's number, got %d", number)
+	}
+	if strings.Join(calls, ",") != "PUT /cards/42/board,POST /cards/42/triage" {
+		t.Errorf("expected no copy after the card moved, got %v", calls)
+	}
+}
+
+func TestMoveOrCopyCardDenied(t *testing.T) {
+	var calls []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, r.Method+" "+strings.TrimPrefix(r.URL.Path, "/test-account"))
+		w.WriteHeader(http.StatusForbidden)
+	}))
+	defer server.Close()
+
+	client, _ := NewClient("/test-account", "test-token", WithBaseURL(server.URL))
+	_, err := client.MoveOrCopyCard(context.Background(), 42, "board-2", "")
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusForbidden {
+		t.Errorf("expected the permission error, got %v", err)
+	}
+	if strings.Join(calls, ",") != "PUT /cards/42/board" {
+		t.Errorf("expected no copy after a permission denial, got %v", calls)
+	}
+}
+
+func TestCopyCardToBoardClosed(t *testing.T) {
+	var calls []string
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /test-account/cards/{number}", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(Card{Number: 42, Title: "Done already", Closed: r.PathValue("number") == "42"})
+	})
+	mux.HandleFunc("GET /test-account/cards/42/comments", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode([]Comment{})
+	})
+	mux.HandleFunc("POST /test-account/boards/board-2/cards", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Location", "/test-account/cards/43")
+		w.WriteHeader(http.StatusCreated)
+	})
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, r.Method+" "+strings.TrimPrefix(r.URL.Path, "/test-account"))
+		if strings.HasSuffix(r.URL.Path, "/comments") {
+			w.WriteHeader(http.StatusCreated)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	client, _ := NewClient("/test-account", "test-token", WithBaseURL(server.URL))
+	if _, err := client.CopyCardToBoard(context.Background(), 42, "board-2", ""); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := "POST /cards/43/comments,POST /cards/42/comments,POST /cards/43/closure"
+	if strings.Join(calls, ",") != expected {
+		t.Errorf("expected the copy to be closed, got %v", calls)
+	}
+}
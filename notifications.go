@@ -2,25 +2,158 @@ package fizzy
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"iter"
 	"net/http"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
 )
 
-func (c *Client) GetNotifications(ctx context.Context) ([]Notification, error) {
-	endpointURL := c.AccountBaseURL + "/notifications"
+// Notification read statuses for NotificationFilters.
+const (
+	NotificationsUnread = "unread"
+	NotificationsRead   = "read"
+)
 
-	req, err := c.newRequest(ctx, http.MethodGet, endpointURL, nil)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create get notifications request: %w", err)
+// NotificationFilters narrows the notifications returned. Empty fields match
+// everything.
+type NotificationFilters struct {
+	// ReadStatus is NotificationsUnread or NotificationsRead.
+	ReadStatus string
+	Since      time.Time
+	CardIDs    []string
+	CreatorIDs []string
+	// Limit stops after this many notifications; zero means no limit.
+	Limit int
+}
+
+func (f NotificationFilters) match(n Notification) bool {
+	switch f.ReadStatus {
+	case NotificationsUnread:
+		if n.Read {
+			return false
+		}
+	case NotificationsRead:
+		if !n.Read {
+			return false
+		}
+	}
+	if !f.Since.IsZero() {
+		created, err := time.Parse(time.RFC3339Nano, n.CreatedAt)
+		if err != nil || created.Before(f.Since) {
+			return false
+		}
 	}
+	if len(f.CardIDs) > 0 && !slices.Contains(f.CardIDs, n.Card.ID) {
+		return false
+	}
+	if len(f.CreatorIDs) > 0 && !slices.Contains(f.CreatorIDs, n.Creator.ID) {
+		return false
+	}
+	return true
+}
 
-	var response []Notification
-	_, err = c.decodeResponse(req, &response)
-	if err != nil {
-		return nil, err
+// Notifications iterates over the user's notifications, unread first,
+// fetching pages as needed. Iteration stops at the first error, which is
+// yielded with a zero Notification.
+//
+//	for n, err := range client.Notifications(ctx, fizzy.NotificationFilters{ReadStatus: fizzy.NotificationsUnread}) {
+//		if err != nil {
+//			return err
+//		}
+//		fmt.Println(n.Title)
+//	}
+//
+// Asking for unread notifications stops at the first read one, so only the
+// pages holding unread notifications are fetched.
+func (c *Client) Notifications(ctx context.Context, filters NotificationFilters) iter.Seq2[Notification, error] {
+	return func(yield func(Notification, error) bool) {
+		endpointURL := c.AccountBaseURL + "/notifications"
+
+		req, err := c.newRequest(ctx, http.MethodGet, endpointURL, nil)
+		if err != nil {
+			yield(Notification{}, fmt.Errorf("failed to create get notifications request: %w", err))
+			return
+		}
+
+		q := req.URL.Query()
+		switch filters.ReadStatus {
+		case NotificationsUnread:
+			q.Set("read", "false")
+		case NotificationsRead:
+			q.Set("read", "true")
+		}
+		if !filters.Since.IsZero() {
+			q.Set("since", filters.Since.UTC().Format(time.RFC3339))
+		}
+		for _, id := range filters.CardIDs {
+			q.Add("card_ids[]", id)
+		}
+		for _, id := range filters.CreatorIDs {
+			q.Add("creator_ids[]", id)
+		}
+		if filters.Limit > 0 {
+			q.Set("limit", strconv.Itoa(filters.Limit))
+		}
+		req.URL.RawQuery = q.Encode()
+
+		count := 0
+		for {
+			var page []Notification
+			header, _, err := c.doRequest(req, &page)
+			if err != nil {
+				yield(Notification{}, err)
+				return
+			}
+
+			// Filters are applied here too in case the server ignores them.
+			for _, n := range page {
+				if n.Read && filters.ReadStatus == NotificationsUnread {
+					return // unread notifications come first, so nothing later matches
+				}
+				if !filters.match(n) {
+					continue
+				}
+				if !yield(n, nil) {
+					return
+				}
+				count++
+				if filters.Limit > 0 && count >= filters.Limit {
+					return
+				}
+			}
+
+			req, err = c.nextPageRequest(req, header)
+			if err != nil {
+				yield(Notification{}, err)
+				return
+			}
+			if req == nil {
+				return
+			}
+		}
 	}
+}
 
-	return response, nil
+// GetNotifications collects the user's notifications, unread first. An
+// optional NotificationFilters narrows them.
+func (c *Client) GetNotifications(ctx context.Context, filters ...NotificationFilters) ([]Notification, error) {
+	var f NotificationFilters
+	if len(filters) > 0 {
+		f = filters[0]
+	}
+
+	notifications := []Notification{}
+	for n, err := range c.Notifications(ctx, f) {
+		if err != nil {
+			return nil, err
+		}
+		notifications = append(notifications, n)
+	}
+	return notifications, nil
 }
 
 func (c *Client) GetNotification(ctx context.Context, notificationID string) (*Notification, error) {
@@ -75,3 +208,35 @@ func (c *Client) MarkAllNotificationsRead(ctx context.Context) error {
 	_, err = c.decodeResponse(req, nil, http.StatusNoContent)
 	return err
 }
+
+// MarkNotificationsRead marks the given notifications read, sending up to
+// DefaultBulkConcurrency requests at a time. It carries on past failures and
+// returns them joined.
+func (c *Client) MarkNotificationsRead(ctx context.Context, notificationIDs []string) error {
+	var (
+		mu   sync.Mutex
+		errs []error
+		wg   sync.WaitGroup
+	)
+	queue := make(chan string)
+	for range min(DefaultBulkConcurrency, len(notificationIDs)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for id := range queue {
+				if err := c.MarkNotificationRead(ctx, id); err != nil {
+					mu.Lock()
+					errs = append(errs, fmt.Errorf("notification %s: %w", id, err))
+					mu.Unlock()
+				}
+			}
+		}()
+	}
+	for _, id := range notificationIDs {
+		queue <- id
+	}
+	close(queue)
+	wg.Wait()
+
+	return errors.Join(errs...)
+}
@@ -5,7 +5,11 @@ import (
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"slices"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 )
 
 func TestGetNotifications(t *testing.T) {
@@ -35,6 +39,135 @@ func TestGetNotifications(t *testing.T) {
 			t.Fatalf("expected 2 notifications, got %d", len(result))
 		}
 	})
+
+	t.Run("sends filters and applies them to the results", func(t *testing.T) {
+		notifications := []Notification{
+			{ID: "notif-1", CreatedAt: "2025-12-06T10:00:00Z", Card: CardReference{ID: "card-1"}, Creator: User{ID: "user-1"}},
+			{ID: "notif-2", CreatedAt: "2025-12-01T10:00:00Z", Card: CardReference{ID: "card-1"}, Creator: User{ID: "user-1"}},
+			{ID: "notif-3", CreatedAt: "2025-12-06T10:00:00Z", Card: CardReference{ID: "card-2"}, Creator: User{ID: "user-1"}},
+			{ID: "notif-4", CreatedAt: "2025-12-06T10:00:00Z", Card: CardReference{ID: "card-1"}, Creator: User{ID: "user-2"}},
+		}
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			q := r.URL.Query()
+			if q.Get("read") != "false" {
+				t.Errorf("expected read=false, got %q", q.Get("read"))
+			}
+			if q.Get("since") != "2025-12-05T00:00:00Z" {
+				t.Errorf("unexpected since: %q", q.Get("since"))
+			}
+			if !slices.Equal(q["card_ids[]"], []string{"card-1"}) || !slices.Equal(q["creator_ids[]"], []string{"user-1"}) {
+				t.Errorf("unexpected query: %s", r.URL.RawQuery)
+			}
+
+			json.NewEncoder(w).Encode(notifications)
+		}))
+		defer server.Close()
+
+		client, _ := NewClient("/test-account", "test-token", WithBaseURL(server.URL))
+		result, err := client.GetNotifications(context.Background(), NotificationFilters{
+			ReadStatus: NotificationsUnread,
+			Since:      time.Date(2025, 12, 5, 0, 0, 0, 0, time.UTC),
+			CardIDs:    []string{"card-1"},
+			CreatorIDs: []string{"user-1"},
+		})
+
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(result) != 1 || result[0].ID != "notif-1" {
+			t.Errorf("expected only notif-1, got %+v", result)
+		}
+	})
+
+	t.Run("returns error on failure", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusInternalServerError)
+		}))
+		defer server.Close()
+
+		client, _ := NewClient("/test-account", "test-token", WithBaseURL(server.URL))
+		if _, err := client.GetNotifications(context.Background()); err == nil {
+			t.Error("expected error, got nil")
+		}
+	})
+}
+
+func TestNotifications(t *testing.T) {
+	newServer := func(t *testing.T, requests *int) *httptest.Server {
+		var server *httptest.Server
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			*requests++
+			switch r.URL.Query().Get("page") {
+			case "":
+				w.Header().Set("Link", `<`+server.URL+`/test-account/notifications?page=2>; rel="next"`)
+				json.NewEncoder(w).Encode([]Notification{{ID: "notif-1"}, {ID: "notif-2"}})
+			case "2":
+				w.Header().Set("Link", `<`+server.URL+`/test-account/notifications?page=3>; rel="next"`)
+				json.NewEncoder(w).Encode([]Notification{{ID: "notif-3"}, {ID: "notif-4", Read: true}})
+			default:
+				json.NewEncoder(w).Encode([]Notification{{ID: "notif-5", Read: true}})
+			}
+		}))
+		t.Cleanup(server.Close)
+		return server
+	}
+
+	collect := func(client *Client, filters NotificationFilters) ([]string, error) {
+		var ids []string
+		for n, err := range client.Notifications(context.Background(), filters) {
+			if err != nil {
+				return ids, err
+			}
+			ids = append(ids, n.ID)
+		}
+		return ids, nil
+	}
+
+	t.Run("follows pages", func(t *testing.T) {
+		var requests int
+		server := newServer(t, &requests)
+		client, _ := NewClient("/test-account", "test-token", WithBaseURL(server.URL))
+
+		ids, err := collect(client, NotificationFilters{ReadStatus: NotificationsRead})
+
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !slices.Equal(ids, []string{"notif-4", "notif-5"}) || requests != 3 {
+			t.Errorf("unexpected notifications %v after %d requests", ids, requests)
+		}
+	})
+
+	t.Run("stops at the first read notification when asking for unread", func(t *testing.T) {
+		var requests int
+		server := newServer(t, &requests)
+		client, _ := NewClient("/test-account", "test-token", WithBaseURL(server.URL))
+
+		ids, err := collect(client, NotificationFilters{ReadStatus: NotificationsUnread})
+
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !slices.Equal(ids, []string{"notif-1", "notif-2", "notif-3"}) || requests != 2 {
+			t.Errorf("unexpected notifications %v after %d requests", ids, requests)
+		}
+	})
+
+	t.Run("stops after the limit", func(t *testing.T) {
+		var requests int
+		server := newServer(t, &requests)
+		client, _ := NewClient("/test-account", "test-token", WithBaseURL(server.URL))
+
+		ids, err := collect(client, NotificationFilters{Limit: 2})
+
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !slices.Equal(ids, []string{"notif-1", "notif-2"}) || requests != 1 {
+			t.Errorf("unexpected notifications %v after %d requests", ids, requests)
+		}
+	})
 }
 
 func TestGetNotification(t *testing.T) {
@@ -131,3 +264,56 @@ func TestMarkAllNotificationsRead(t *testing.T) {
 		}
 	})
 }
+
+func TestMarkNotificationsRead(t *testing.T) {
+	t.Run("marks every notification as read", func(t *testing.T) {
+		var (
+			mu   sync.Mutex
+			read []string
+		)
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodPost {
+				t.Errorf("expected POST, got %s", r.Method)
+			}
+			mu.Lock()
+			read = append(read, strings.TrimPrefix(r.URL.Path, "/test-account/notifications/"))
+			mu.Unlock()
+
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		defer server.Close()
+
+		client, _ := NewClient("/test-account", "test-token", WithBaseURL(server.URL))
+		err := client.MarkNotificationsRead(context.Background(), []string{"notif-1", "notif-2", "notif-3", "notif-4", "notif-5"})
+
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		slices.Sort(read)
+		want := []string{"notif-1/reading", "notif-2/reading", "notif-3/reading", "notif-4/reading", "notif-5/reading"}
+		if !slices.Equal(read, want) {
+			t.Errorf("unexpected requests: %v", read)
+		}
+	})
+
+	t.Run("returns every failure", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if strings.Contains(r.URL.Path, "notif-2") {
+				w.WriteHeader(http.StatusNoContent)
+				return
+			}
+			w.WriteHeader(http.StatusNotFound)
+		}))
+		defer server.Close()
+
+		client, _ := NewClient("/test-account", "test-token", WithBaseURL(server.URL))
+		err := client.MarkNotificationsRead(context.Background(), []string{"notif-1", "notif-2", "notif-3"})
+
+		if err == nil {
+			t.Fatal("expected error, got nil")
+		}
+		if !strings.Contains(err.Error(), "notification notif-1") || !strings.Contains(err.Error(), "notification notif-3") || strings.Contains(err.Error(), "notif-2") {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+}
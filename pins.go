@@ -0,0 +1,69 @@
+package fizzy
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+)
+
+// PinCard pins a card to the current user's tray.
+func (c *Client) PinCard(ctx context.Context, cardNumber int) error {
+	endpointURL := fmt.Sprintf("%s/cards/%d/pin", c.AccountBaseURL, cardNumber)
+
+	req, err := c.newRequest(ctx, http.MethodPost, endpointURL, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create post pin request: %w", err)
+	}
+
+	_, err = c.decodeResponse(req, nil, http.StatusNoContent)
+	return err
+}
+
+func (c *Client) UnpinCard(ctx context.Context, cardNumber int) error {
+	endpointURL := fmt.Sprintf("%s/cards/%d/pin", c.AccountBaseURL, cardNumber)
+
+	req, err := c.newRequest(ctx, http.MethodDelete, endpointURL, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create delete pin request: %w", err)
+	}
+
+	_, err = c.decodeResponse(req, nil, http.StatusNoContent)
+	return err
+}
+
+// GetPinnedCards returns the cards the current user has pinned.
+func (c *Client) GetPinnedCards(ctx context.Context) ([]Card, error) {
+	endpointURL := c.AccountBaseURL + "/my/pins"
+
+	req, err := c.newRequest(ctx, http.MethodGet, endpointURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create get pins request: %w", err)
+	}
+
+	return getAllPages[Card](c, req)
+}
+
+// GetWatchedCards returns the cards matching filters that userID watches,
+// judged by the watchers each card is listed with. Narrow filters keep the
+// listing short on large accounts. The documented card listing carries no
+// watchers, so when none of the listed cards has them it returns an error
+// wrapping ErrNotSupported rather than an empty result.
+func (c *Client) GetWatchedCards(ctx context.Context, userID string, filters CardFilters) ([]Card, error) {
+	cards, err := c.GetCards(ctx, filters)
+	if err != nil {
+		return nil, err
+	}
+	if len(cards) > 0 && !slices.ContainsFunc(cards, func(card Card) bool { return card.Watchers != nil }) {
+		return nil, fmt.Errorf("card listing has no watchers: %w", ErrNotSupported)
+	}
+
+	watched := []Card{}
+	for _, card := range cards {
+		if slices.ContainsFunc(card.Watchers, func(u User) bool { return u.ID == userID }) {
+			watched = append(watched, card)
+		}
+	}
+
+	return watched, nil
+}
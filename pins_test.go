@@ -0,0 +1,126 @@
+package fizzy
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestPinCard(t *testing.T) {
+	t.Run("pins card", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodPost {
+				t.Errorf("expected POST, got %s", r.Method)
+			}
+			if r.URL.Path != "/test-account/cards/42/pin" {
+				t.Errorf("unexpected path: %s", r.URL.Path)
+			}
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		defer server.Close()
+
+		client, _ := NewClient("/test-account", "test-token", WithBaseURL(server.URL))
+		err := client.PinCard(context.Background(), 42)
+
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("returns error on failure", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+		}))
+		defer server.Close()
+
+		client, _ := NewClient("/test-account", "test-token", WithBaseURL(server.URL))
+		err := client.PinCard(context.Background(), 42)
+
+		if err == nil {
+			t.Fatal("expected error, got nil")
+		}
+	})
+}
+
+func TestUnpinCard(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete {
+			t.Errorf("expected DELETE, got %s", r.Method)
+		}
+		if r.URL.Path != "/test-account/cards/42/pin" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client, _ := NewClient("/test-account", "test-token", WithBaseURL(server.URL))
+	err := client.UnpinCard(context.Background(), 42)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestGetPinnedCards(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/test-account/my/pins" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		json.NewEncoder(w).Encode([]Card{{Number: 1}, {Number: 2}})
+	}))
+	defer server.Close()
+
+	client, _ := NewClient("/test-account", "test-token", WithBaseURL(server.URL))
+	cards, err := client.GetPinnedCards(context.Background())
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(cards) != 2 {
+		t.Errorf("expected 2 cards, got %d", len(cards))
+	}
+}
+
+func TestGetWatchedCards(t *testing.T) {
+	var calls []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, r.Method+" "+r.URL.Path)
+		json.NewEncoder(w).Encode([]Card{
+			{Number: 1, Watchers: []User{{ID: "user-1"}}},
+			{Number: 2, Watchers: []User{{ID: "user-2"}}},
+			{Number: 3, Watchers: []User{{ID: "user-2"}, {ID: "user-1"}}},
+			{Number: 4},
+		})
+	}))
+	defer server.Close()
+
+	client, _ := NewClient("/test-account", "test-token", WithBaseURL(server.URL))
+	cards, err := client.GetWatchedCards(context.Background(), "user-1", CardFilters{})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(cards) != 2 || cards[0].Number != 1 || cards[1].Number != 3 {
+		t.Errorf("unexpected cards: %+v", cards)
+	}
+	if len(calls) != 1 || calls[0] != "GET /test-account/cards" {
+		t.Errorf("expected a single listing request, got %v", calls)
+	}
+
+	t.Run("fails when the listing has no watchers", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			json.NewEncoder(w).Encode([]Card{{Number: 1}, {Number: 2}})
+		}))
+		defer server.Close()
+
+		client, _ := NewClient("/test-account", "test-token", WithBaseURL(server.URL))
+		_, err := client.GetWatchedCards(context.Background(), "user-1", CardFilters{})
+		if !errors.Is(err, ErrNotSupported) {
+			t.Errorf("expected ErrNotSupported, got %v", err)
+		}
+	})
+}
@@ -3,7 +3,6 @@ package fizzy
 import (
 	"context"
 	"fmt"
-	"io"
 	"net/http"
 )
 
@@ -15,13 +14,7 @@ func (c *Client) GetCommentReactions(ctx context.Context, cardNumber int, commen
 		return nil, fmt.Errorf("failed to create get comment reactions request: %w", err)
 	}
 
-	var response []Reaction
-	_, err = c.decodeResponse(req, &response)
-	if err != nil {
-		return nil, err
-	}
-
-	return response, nil
+	return getAllPages[Reaction](c, req)
 }
 
 // CreateCommentReaction adds a reaction to a comment. Returns a Reaction with
@@ -38,15 +31,9 @@ func (c *Client) CreateCommentReaction(ctx context.Context, cardNumber int, comm
 		return nil, fmt.Errorf("failed to create post comment reaction request: %w", err)
 	}
 
-	res, err := c.HTTPClient.Do(req)
+	_, _, err = c.doRequest(req, nil, http.StatusCreated)
 	if err != nil {
-		return nil, fmt.Errorf("failed to make request: %w", err)
-	}
-	defer res.Body.Close()
-
-	if res.StatusCode != http.StatusCreated {
-		body, _ := io.ReadAll(res.Body)
-		return nil, fmt.Errorf("unexpected status code %d: %s", res.StatusCode, string(body))
+		return nil, err
 	}
 
 	return &Reaction{Content: content}, nil
@@ -0,0 +1,48 @@
+package schedule
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseCron(t *testing.T) {
+	for _, expr := range []string{"", "* * * *", "60 * * * *", "* 24 * * *", "* * 0 * *", "* * * 13 *", "* * * * 8", "5-1 * * * *", "*/0 * * * *", "* * * foo *"} {
+		if _, err := ParseCron(expr); err == nil {
+			t.Errorf("expected error for %q, got nil", expr)
+		}
+	}
+}
+
+func TestCronNext(t *testing.T) {
+	lisbon, _ := time.LoadLocation("Europe/Lisbon")
+	// Wednesday
+	from := time.Date(2025, 12, 3, 10, 17, 30, 0, time.UTC)
+
+	for _, tc := range []struct {
+		expr string
+		from time.Time
+		want time.Time
+	}{
+		{"* * * * *", from, time.Date(2025, 12, 3, 10, 18, 0, 0, time.UTC)},
+		{"*/15 * * * *", from, time.Date(2025, 12, 3, 10, 30, 0, 0, time.UTC)},
+		{"0 9 * * mon", from, time.Date(2025, 12, 8, 9, 0, 0, 0, time.UTC)},
+		{"0 9 * * 1-5", from, time.Date(2025, 12, 4, 9, 0, 0, 0, time.UTC)},
+		{"30 8,17 * * *", from, time.Date(2025, 12, 3, 17, 30, 0, 0, time.UTC)},
+		{"0 0 1 * *", from, time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"0 0 * * 7", from, time.Date(2025, 12, 7, 0, 0, 0, 0, time.UTC)},
+		{"@monthly", from, time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"0 12 29 feb *", from, time.Date(2028, 2, 29, 12, 0, 0, 0, time.UTC)},
+		// Day of month or day of week when both are restricted.
+		{"0 0 10 * fri", from, time.Date(2025, 12, 5, 0, 0, 0, 0, time.UTC)},
+		{"0 0 30 feb *", from, time.Time{}},
+		{"0 9 * * *", time.Date(2025, 12, 3, 10, 0, 0, 0, lisbon), time.Date(2025, 12, 4, 9, 0, 0, 0, lisbon)},
+	} {
+		c, err := ParseCron(tc.expr)
+		if err != nil {
+			t.Fatalf("unexpected error for %q: %v", tc.expr, err)
+		}
+		if got := c.Next(tc.from); !got.Equal(tc.want) {
+			t.Errorf("%q: expected %v, got %v", tc.expr, tc.want, got)
+		}
+	}
+}
@@ -0,0 +1,142 @@
+// Package schedule creates recurring cards.
+//
+// A Config lists jobs, each a cron expression and the card to create when
+// it fires:
+//
+//	timezone: Europe/Lisbon
+//	jobs:
+//	  - name: weekly-checklist
+//	    schedule: "0 9 * * mon"
+//	    board: Ops
+//	    column: This week
+//	    title: "Ops checklist for week {{.Week}} ({{.Date}})"
+//	    tags: [ops]
+//	    steps: [Rotate logs, Check backups, Review alerts]
+//
+// A Scheduler creates the cards when they are due and records each job's
+// latest occurrence in a Store, so restarts neither duplicate nor, within
+// reason, miss cards:
+//
+//	store, err := schedule.NewFileStore("schedule-state.json")
+//	scheduler, err := schedule.New(client, cfg, store)
+//	err = scheduler.Run(ctx)
+package schedule
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/rogeriopvl/fizzy-go/internal/shared"
+	"gopkg.in/yaml.v3"
+)
+
+// Config is a set of recurring cards.
+type Config struct {
+	// Timezone is the IANA time zone schedules are read in. The local time
+	// zone is used when empty.
+	Timezone string `json:"timezone,omitempty" yaml:"timezone,omitempty"`
+
+	Jobs []Job `json:"jobs" yaml:"jobs"`
+}
+
+// Job is a card created on a schedule.
+type Job struct {
+	// Name identifies the job in the Store; renaming a job resets its
+	// history.
+	Name     string `json:"name" yaml:"name"`
+	Schedule string `json:"schedule" yaml:"schedule"`
+
+	// Board is the name or ID of the board the card is created on and
+	// Column, if set, the name or ID of the column it is placed in.
+	// Otherwise the card waits in triage.
+	Board  string `json:"board" yaml:"board"`
+	Column string `json:"column,omitempty" yaml:"column,omitempty"`
+
+	// Title and Description are text/template templates executed with an
+	// Occurrence.
+	Title       string   `json:"title" yaml:"title"`
+	Description string   `json:"description,omitempty" yaml:"description,omitempty"`
+	Tags        []string `json:"tags,omitempty" yaml:"tags,omitempty"`
+	Steps       []string `json:"steps,omitempty" yaml:"steps,omitempty"`
+}
+
+// Occurrence is the data titles and descriptions are rendered with.
+type Occurrence struct {
+	Job  string
+	Time time.Time
+}
+
+// Date returns the occurrence's date as 2006-01-02.
+func (o Occurrence) Date() string {
+	return o.Time.Format(time.DateOnly)
+}
+
+// Week returns the occurrence's ISO week number.
+func (o Occurrence) Week() int {
+	_, week := o.Time.ISOWeek()
+	return week
+}
+
+// Load decodes a Config from YAML or JSON and validates it.
+func Load(r io.Reader) (*Config, error) {
+	var cfg Config
+	if err := yaml.NewDecoder(r).Decode(&cfg); err != nil && err != io.EOF {
+		return nil, fmt.Errorf("failed to decode schedule config: %w", err)
+	}
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	return &cfg, nil
+}
+
+// Validate checks the time zone, and for unnamed or duplicate jobs, invalid
+// schedules and templates, and jobs without a board or title.
+func (cfg *Config) Validate() error {
+	if _, err := cfg.location(); err != nil {
+		return fmt.Errorf("schedule config: %w", err)
+	}
+
+	names := make(map[string]bool, len(cfg.Jobs))
+	for i, job := range cfg.Jobs {
+		if job.Name == "" {
+			return fmt.Errorf("schedule config: job %d has no name", i+1)
+		}
+		if names[job.Name] {
+			return fmt.Errorf("schedule config: job %q is defined twice", job.Name)
+		}
+		names[job.Name] = true
+
+		if _, err := ParseCron(job.Schedule); err != nil {
+			return fmt.Errorf("schedule config: job %q: %w", job.Name, err)
+		}
+		if job.Board == "" {
+			return fmt.Errorf("schedule config: job %q has no board", job.Name)
+		}
+		if job.Title == "" {
+			return fmt.Errorf("schedule config: job %q has no title", job.Name)
+		}
+		// Tagging toggles, so a tag listed twice would be removed again.
+		tags := make(map[string]bool, len(job.Tags))
+		for _, tag := range job.Tags {
+			key := shared.TagKey(tag)
+			if tags[key] {
+				return fmt.Errorf("schedule config: job %q lists tag %q twice", job.Name, tag)
+			}
+			tags[key] = true
+		}
+		for _, text := range []string{job.Title, job.Description} {
+			if _, err := shared.Render(text, Occurrence{Job: job.Name, Time: time.Now()}); err != nil {
+				return fmt.Errorf("schedule config: job %q: %w", job.Name, err)
+			}
+		}
+	}
+	return nil
+}
+
+func (cfg *Config) location() (*time.Location, error) {
+	if cfg.Timezone == "" {
+		return time.Local, nil
+	}
+	return time.LoadLocation(cfg.Timezone)
+}
@@ -0,0 +1,63 @@
+package schedule
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rogeriopvl/fizzy-go/internal/shared"
+)
+
+func TestLoad(t *testing.T) {
+	t.Run("parses YAML", func(t *testing.T) {
+		cfg, err := Load(strings.NewReader(`
+timezone: Europe/Lisbon
+jobs:
+  - name: weekly
+    schedule: "0 9 * * mon"
+    board: Ops
+    column: This week
+    title: "Checklist {{.Date}}"
+    tags: [ops]
+    steps: [Rotate logs, Check backups]
+`))
+
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if cfg.Timezone != "Europe/Lisbon" || len(cfg.Jobs) != 1 {
+			t.Fatalf("unexpected config: %+v", cfg)
+		}
+		if job := cfg.Jobs[0]; job.Column != "This week" || len(job.Steps) != 2 || job.Tags[0] != "ops" {
+			t.Errorf("unexpected job: %+v", job)
+		}
+	})
+
+	for name, doc := range map[string]string{
+		"rejects unknown time zones": "timezone: Mars/Olympus\n",
+		"rejects unnamed jobs":       "jobs:\n  - schedule: '@daily'\n    board: Ops\n    title: T\n",
+		"rejects duplicate jobs":     "jobs:\n  - {name: a, schedule: '@daily', board: Ops, title: T}\n  - {name: a, schedule: '@daily', board: Ops, title: T}\n",
+		"rejects invalid schedules":  "jobs:\n  - {name: a, schedule: 'every monday', board: Ops, title: T}\n",
+		"rejects jobs without board": "jobs:\n  - {name: a, schedule: '@daily', title: T}\n",
+		"rejects invalid templates":  "jobs:\n  - {name: a, schedule: '@daily', board: Ops, title: '{{.Nope}}'}\n",
+		"rejects duplicate tags":     "jobs:\n  - {name: a, schedule: '@daily', board: Ops, title: T, tags: [ops, '#Ops']}\n",
+	} {
+		t.Run(name, func(t *testing.T) {
+			if _, err := Load(strings.NewReader(doc)); err == nil {
+				t.Fatal("expected error, got nil")
+			}
+		})
+	}
+}
+
+func TestOccurrence(t *testing.T) {
+	o := Occurrence{Job: "weekly", Time: time.Date(2025, 12, 8, 9, 0, 0, 0, time.UTC)}
+
+	got, err := shared.Render(`{{.Job}} week {{.Week}} {{.Date}} {{.Time.Format "Jan 2"}}`, o)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := "weekly week 50 2025-12-08 Dec 8"; got != want {
+		t.Errorf("expected %q, got %q", want, got)
+	}
+}
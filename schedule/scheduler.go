@@ -0,0 +1,243 @@
+package schedule
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	fizzy "github.com/rogeriopvl/fizzy-go"
+	"github.com/rogeriopvl/fizzy-go/internal/shared"
+)
+
+// Created records a card a job created, or found already created.
+type Created struct {
+	Job   string
+	At    time.Time
+	Title string
+	Card  int
+	// Existing is set when an open card with the same title was already on
+	// the board, so none was created.
+	Existing bool
+}
+
+// StoreError is a failure of the Scheduler's Store. Run stops on it, as
+// the scheduler can no longer tell which occurrences it has handled.
+type StoreError struct {
+	Job string
+	Err error
+}
+
+func (e *StoreError) Error() string {
+	return fmt.Sprintf("job %s: %v", e.Job, e.Err)
+}
+
+func (e *StoreError) Unwrap() error {
+	return e.Err
+}
+
+// Scheduler creates the cards of a Config's jobs when they are due.
+type Scheduler struct {
+	client  *fizzy.Client
+	jobs    []scheduledJob
+	loc     *time.Location
+	store   Store
+	now     func() time.Time
+	onError func(error)
+}
+
+type scheduledJob struct {
+	Job
+	cron *Cron
+}
+
+func New(client *fizzy.Client, cfg *Config, store Store) (*Scheduler, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	loc, _ := cfg.location()
+
+	s := &Scheduler{client: client, loc: loc, store: store, now: time.Now}
+	for _, job := range cfg.Jobs {
+		cron, _ := ParseCron(job.Schedule)
+		s.jobs = append(s.jobs, scheduledJob{Job: job, cron: cron})
+	}
+	return s, nil
+}
+
+// RunDue creates the cards of every job with an occurrence since its last
+// run. When several occurrences were missed, say while the scheduler was
+// down, only the latest is created. Jobs seen for the first time start
+// from now without creating anything.
+//
+// A card is not created twice: before creating one, the board is searched
+// for an open card with the same title, which covers a crash between
+// creating a card and recording it. Failing jobs are retried on the next
+// run; the returned error joins their errors, with store failures as
+// *StoreError.
+func (s *Scheduler) RunDue(ctx context.Context) ([]Created, error) {
+	now := s.now().In(s.loc)
+
+	var boards []fizzy.Board
+	var created []Created
+	var errs []error
+	for _, job := range s.jobs {
+		last, ok, err := s.store.Last(job.Name)
+		if err != nil {
+			errs = append(errs, &StoreError{Job: job.Name, Err: err})
+			continue
+		}
+		if !ok {
+			if err := s.store.SetLast(job.Name, now); err != nil {
+				errs = append(errs, &StoreError{Job: job.Name, Err: err})
+			}
+			continue
+		}
+
+		at := job.cron.Next(last.In(s.loc))
+		if at.IsZero() || at.After(now) {
+			continue
+		}
+		for next := job.cron.Next(at); !next.IsZero() && !next.After(now); next = job.cron.Next(at) {
+			at = next
+		}
+
+		if boards == nil {
+			if boards, err = s.client.GetBoards(ctx); err != nil {
+				return created, errors.Join(append(errs, fmt.Errorf("failed to list boards: %w", err))...)
+			}
+		}
+
+		c, err := s.create(ctx, job.Job, at, boards)
+		if c.Card != 0 {
+			created = append(created, c)
+			// The card exists, so the occurrence is done even if setting it
+			// up failed part way.
+			if err := s.store.SetLast(job.Name, at); err != nil {
+				errs = append(errs, &StoreError{Job: job.Name, Err: err})
+			}
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("job %s: %w", job.Name, err))
+		}
+	}
+
+	return created, errors.Join(errs...)
+}
+
+func (s *Scheduler) create(ctx context.Context, job Job, at time.Time, boards []fizzy.Board) (Created, error) {
+	occurrence := Occurrence{Job: job.Name, Time: at}
+	c := Created{Job: job.Name, At: at}
+
+	var err error
+	if c.Title, err = shared.Render(job.Title, occurrence); err != nil {
+		return c, err
+	}
+	description, err := shared.Render(job.Description, occurrence)
+	if err != nil {
+		return c, err
+	}
+
+	i := slices.IndexFunc(boards, func(board fizzy.Board) bool { return shared.MatchName(job.Board, board.ID, board.Name) })
+	if i < 0 {
+		return c, fmt.Errorf("board %q not found", job.Board)
+	}
+	board := boards[i]
+
+	existing, err := s.client.GetCards(ctx, fizzy.CardFilters{BoardIDs: []string{board.ID}, Terms: []string{c.Title}})
+	if err != nil {
+		return c, fmt.Errorf("failed to search for existing card: %w", err)
+	}
+	for _, card := range existing {
+		if card.Title == c.Title && !card.Closed {
+			c.Card, c.Existing = card.Number, true
+			return c, nil
+		}
+	}
+
+	c.Card, err = s.client.ForBoard(board.ID).CreateCardAndGetNumber(ctx, fizzy.CreateCardPayload{Title: c.Title, Description: description})
+	if err != nil {
+		return c, fmt.Errorf("failed to create card: %w", err)
+	}
+
+	for _, tag := range job.Tags {
+		if err := s.client.TagCard(ctx, c.Card, tag); err != nil {
+			return c, fmt.Errorf("failed to tag card #%d: %w", c.Card, err)
+		}
+	}
+	for _, step := range job.Steps {
+		if _, err := s.client.CreateCardStep(ctx, c.Card, step, false); err != nil {
+			return c, fmt.Errorf("failed to add step to card #%d: %w", c.Card, err)
+		}
+	}
+	if job.Column != "" {
+		columns, err := s.client.ForBoard(board.ID).GetColumns(ctx)
+		if err != nil {
+			return c, fmt.Errorf("failed to list columns: %w", err)
+		}
+		i := slices.IndexFunc(columns, func(column fizzy.Column) bool { return shared.MatchName(job.Column, column.ID, column.Name) })
+		if i < 0 {
+			return c, fmt.Errorf("column %q not found on board %s", job.Column, board.Name)
+		}
+		if err := s.client.TriageCard(ctx, c.Card, columns[i].ID); err != nil {
+			return c, fmt.Errorf("failed to triage card #%d: %w", c.Card, err)
+		}
+	}
+
+	return c, nil
+}
+
+// Next returns the time of the next occurrence of any job, or the zero
+// time if there is none.
+func (s *Scheduler) Next() time.Time {
+	now := s.now().In(s.loc)
+	var next time.Time
+	for _, job := range s.jobs {
+		if at := job.cron.Next(now); !at.IsZero() && (next.IsZero() || at.Before(next)) {
+			next = at
+		}
+	}
+	return next
+}
+
+// OnError sets a function Run calls with the errors of each run it carries
+// on after, such as a job whose board is missing.
+func (s *Scheduler) OnError(fn func(error)) {
+	s.onError = fn
+}
+
+// Run creates due cards, then sleeps until the next occurrence, until ctx
+// is done. Failing jobs are reported to the OnError function and retried
+// on the next run, a minute later for temporary API errors, see
+// fizzy.APIError.Temporary. Only store errors stop it.
+func (s *Scheduler) Run(ctx context.Context) error {
+	for {
+		wait := time.Minute
+		_, err := s.RunDue(ctx)
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		var storeErr *StoreError
+		if errors.As(err, &storeErr) {
+			return err
+		}
+		if err != nil && s.onError != nil {
+			s.onError(err)
+		}
+
+		var apiErr *fizzy.APIError
+		temporary := errors.As(err, &apiErr) && apiErr.Temporary()
+		if next := s.Next(); !temporary && !next.IsZero() {
+			wait = time.Until(next)
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
@@ -0,0 +1,245 @@
+package schedule
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	fizzy "github.com/rogeriopvl/fizzy-go"
+)
+
+var weeklyJob = Job{
+	Name:     "weekly",
+	Schedule: "0 9 * * mon",
+	Board:    "ops",
+	Column:   "This week",
+	Title:    "Checklist {{.Date}}",
+	Tags:     []string{"ops"},
+	Steps:    []string{"Rotate logs", "Check backups"},
+}
+
+// monday is an occurrence of weeklyJob.
+var monday = time.Date(2025, 12, 8, 9, 0, 0, 0, time.UTC)
+
+type fakeFizzy struct {
+	mu       sync.Mutex
+	requests []string
+	existing []fizzy.Card
+}
+
+func (f *fakeFizzy) changes() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]string(nil), f.requests...)
+}
+
+func newScheduler(t *testing.T, fake *fakeFizzy, store Store, jobs ...Job) *Scheduler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /test-account/boards", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode([]fizzy.Board{{ID: "board-1", Name: "Ops"}})
+	})
+	mux.HandleFunc("GET /test-account/boards/board-1/columns", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode([]fizzy.Column{{ID: "col-1", Name: "This week"}})
+	})
+	mux.HandleFunc("GET /test-account/cards", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(fake.existing)
+	})
+	mux.HandleFunc("POST /", func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		fake.mu.Lock()
+		fake.requests = append(fake.requests, r.URL.Path+" "+strings.TrimSpace(string(body)))
+		fake.mu.Unlock()
+
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/boards/board-1/cards"):
+			w.Header().Set("Location", "/test-account/cards/77")
+			w.WriteHeader(http.StatusCreated)
+		case strings.HasSuffix(r.URL.Path, "/steps"):
+			w.Header().Set("Location", r.URL.Path+"/step-1")
+			w.WriteHeader(http.StatusCreated)
+		default:
+			w.WriteHeader(http.StatusNoContent)
+		}
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	client, _ := fizzy.NewClient("/test-account", "test-token", fizzy.WithBaseURL(server.URL))
+	s, err := New(client, &Config{Timezone: "UTC", Jobs: jobs}, store)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return s
+}
+
+func newStore(t *testing.T) *FileStore {
+	store, err := NewFileStore(filepath.Join(t.TempDir(), "state.json"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return store
+}
+
+func TestRunDue(t *testing.T) {
+	t.Run("starts new jobs from now", func(t *testing.T) {
+		fake := &fakeFizzy{}
+		store := newStore(t)
+		s := newScheduler(t, fake, store, weeklyJob)
+		s.now = func() time.Time { return monday.Add(time.Hour) }
+
+		created, err := s.RunDue(context.Background())
+
+		if err != nil || len(created) != 0 {
+			t.Errorf("expected nothing created, got %+v, %v", created, err)
+		}
+		if last, ok, _ := store.Last("weekly"); !ok || !last.Equal(monday.Add(time.Hour)) {
+			t.Errorf("expected baseline at now, got %v", last)
+		}
+	})
+
+	t.Run("creates due cards once", func(t *testing.T) {
+		fake := &fakeFizzy{}
+		store := newStore(t)
+		store.SetLast("weekly", monday.Add(-time.Hour))
+		s := newScheduler(t, fake, store, weeklyJob)
+		s.now = func() time.Time { return monday.Add(5 * time.Minute) }
+
+		created, err := s.RunDue(context.Background())
+
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(created) != 1 || created[0].Card != 77 || created[0].Title != "Checklist 2025-12-08" || created[0].Existing {
+			t.Errorf("unexpected created cards: %+v", created)
+		}
+		changes := fake.changes()
+		if len(changes) != 5 {
+			t.Fatalf("expected card, tag, 2 steps and triage, got %v", changes)
+		}
+		if !strings.Contains(changes[0], `"title":"Checklist 2025-12-08"`) || !strings.Contains(changes[4], `"col-1"`) {
+			t.Errorf("unexpected changes: %v", changes)
+		}
+		if last, _, _ := store.Last("weekly"); !last.Equal(monday) {
+			t.Errorf("expected last occurrence %v, got %v", monday, last)
+		}
+
+		again, _ := s.RunDue(context.Background())
+		if len(again) != 0 || len(fake.changes()) != 5 {
+			t.Errorf("expected no second card, got %+v", again)
+		}
+	})
+
+	t.Run("creates only the latest missed occurrence", func(t *testing.T) {
+		fake := &fakeFizzy{}
+		store := newStore(t)
+		store.SetLast("weekly", monday.AddDate(0, 0, -21))
+		s := newScheduler(t, fake, store, weeklyJob)
+		s.now = func() time.Time { return monday.Add(time.Minute) }
+
+		created, _ := s.RunDue(context.Background())
+
+		if len(created) != 1 || !created[0].At.Equal(monday) {
+			t.Errorf("expected only the card for %v, got %+v", monday, created)
+		}
+	})
+
+	t.Run("finds cards created before a crash", func(t *testing.T) {
+		fake := &fakeFizzy{existing: []fizzy.Card{{Number: 12, Title: "Checklist 2025-12-08"}}}
+		store := newStore(t)
+		store.SetLast("weekly", monday.Add(-time.Hour))
+		s := newScheduler(t, fake, store, weeklyJob)
+		s.now = func() time.Time { return monday.Add(time.Minute) }
+
+		created, err := s.RunDue(context.Background())
+
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(created) != 1 || created[0].Card != 12 || !created[0].Existing {
+			t.Errorf("expected existing card #12, got %+v", created)
+		}
+		if changes := fake.changes(); len(changes) != 0 {
+			t.Errorf("expected no changes, got %v", changes)
+		}
+	})
+
+	t.Run("returns error on failure", func(t *testing.T) {
+		fake := &fakeFizzy{}
+		store := newStore(t)
+		store.SetLast("weekly", monday.Add(-time.Hour))
+		job := weeklyJob
+		job.Board = "Missing"
+		s := newScheduler(t, fake, store, job)
+		s.now = func() time.Time { return monday.Add(time.Minute) }
+
+		if _, err := s.RunDue(context.Background()); err == nil {
+			t.Error("expected error, got nil")
+		}
+		if last, _, _ := store.Last("weekly"); !last.Equal(monday.Add(-time.Hour)) {
+			t.Errorf("expected the occurrence to be retried, got last %v", last)
+		}
+	})
+}
+
+// failingStore fails every write.
+type failingStore struct{ Store }
+
+func (failingStore) SetLast(string, time.Time) error {
+	return errors.New("disk full")
+}
+
+func TestRun(t *testing.T) {
+	t.Run("keeps running when a job fails", func(t *testing.T) {
+		store := newStore(t)
+		store.SetLast("weekly", monday.Add(-time.Hour))
+		job := weeklyJob
+		job.Board = "Missing"
+		s := newScheduler(t, &fakeFizzy{}, store, job)
+		s.now = func() time.Time { return monday.Add(time.Minute) }
+
+		ctx, cancel := context.WithCancel(context.Background())
+		var failures []error
+		s.OnError(func(err error) {
+			failures = append(failures, err)
+			if len(failures) == 2 {
+				cancel()
+			}
+		})
+
+		if err := s.Run(ctx); err != context.Canceled {
+			t.Errorf("expected Run to stop only when cancelled, got %v", err)
+		}
+		if len(failures) != 2 || !strings.Contains(failures[0].Error(), `board "Missing" not found`) {
+			t.Errorf("expected the job failure to be reported on each run, got %v", failures)
+		}
+	})
+
+	t.Run("stops on store errors", func(t *testing.T) {
+		s := newScheduler(t, &fakeFizzy{}, failingStore{newStore(t)}, weeklyJob)
+
+		err := s.Run(context.Background())
+
+		var storeErr *StoreError
+		if !errors.As(err, &storeErr) || storeErr.Job != "weekly" {
+			t.Errorf("expected a store error, got %v", err)
+		}
+	})
+}
+
+func TestNext(t *testing.T) {
+	daily := Job{Name: "daily", Schedule: "0 8 * * *", Board: "Ops", Title: "Standup"}
+	s := newScheduler(t, &fakeFizzy{}, newStore(t), weeklyJob, daily)
+	s.now = func() time.Time { return monday.Add(-2 * time.Hour) }
+
+	if next := s.Next(); !next.Equal(monday.Add(-time.Hour)) {
+		t.Errorf("expected the daily job at 08:00, got %v", next)
+	}
+}
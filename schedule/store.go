@@ -0,0 +1,69 @@
+package schedule
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/rogeriopvl/fizzy-go/internal/shared"
+)
+
+// Store remembers the latest occurrence handled for each job.
+type Store interface {
+	// Last returns the job's latest handled occurrence, and false if the
+	// job has never run.
+	Last(job string) (time.Time, bool, error)
+	SetLast(job string, at time.Time) error
+}
+
+// FileStore keeps the state in a JSON file, which is rewritten atomically
+// on every change.
+type FileStore struct {
+	path string
+
+	mu   sync.Mutex
+	last map[string]time.Time
+}
+
+// NewFileStore opens the state file at path, starting empty if it does
+// not exist yet.
+func NewFileStore(path string) (*FileStore, error) {
+	fs := &FileStore{path: path, last: make(map[string]time.Time)}
+
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return fs, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read schedule state: %w", err)
+	}
+	if err := json.Unmarshal(data, &fs.last); err != nil {
+		return nil, fmt.Errorf("failed to decode schedule state: %w", err)
+	}
+	return fs, nil
+}
+
+func (fs *FileStore) Last(job string) (time.Time, bool, error) {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+	at, ok := fs.last[job]
+	return at, ok, nil
+}
+
+func (fs *FileStore) SetLast(job string, at time.Time) error {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+
+	fs.last[job] = at
+	data, err := json.MarshalIndent(fs.last, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err := shared.WriteFileAtomic(fs.path, data); err != nil {
+		return fmt.Errorf("failed to write schedule state: %w", err)
+	}
+	return nil
+}
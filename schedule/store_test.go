@@ -0,0 +1,37 @@
+package schedule
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestFileStore(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "state.json")
+	at := time.Date(2025, 12, 8, 9, 0, 0, 0, time.UTC)
+
+	store, err := NewFileStore(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, ok, _ := store.Last("weekly"); ok {
+		t.Error("expected no history for a new store")
+	}
+	if err := store.SetLast("weekly", at); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	reopened, err := NewFileStore(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if last, ok, _ := reopened.Last("weekly"); !ok || !last.Equal(at) {
+		t.Errorf("expected %v to survive a restart, got %v", at, last)
+	}
+
+	os.WriteFile(path, []byte("not json"), 0o644)
+	if _, err := NewFileStore(path); err == nil {
+		t.Error("expected error for corrupt state, got nil")
+	}
+}
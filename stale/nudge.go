@@ -0,0 +1,109 @@
+package stale
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"html"
+	"strings"
+	"time"
+
+	fizzy "github.com/rogeriopvl/fizzy-go"
+)
+
+// NudgeMode is how Nudge keeps a card from being postponed.
+type NudgeMode string
+
+const (
+	// NudgeComment comments on the card, addressing its owners. The comment
+	// is itself activity, so it also restarts the card's period.
+	NudgeComment NudgeMode = "comment"
+	// NudgeBump sets the card's last activity to now without notifying
+	// anyone.
+	NudgeBump NudgeMode = "bump"
+)
+
+// NudgeOptions controls what Nudge does.
+type NudgeOptions struct {
+	Mode NudgeMode
+
+	// Message builds the comment for NudgeComment. DefaultMessage is used
+	// when it is nil.
+	Message func(Candidate) string
+
+	// DryRun reports what would be done without doing it.
+	DryRun bool
+}
+
+// NudgeResult records the outcome of nudging one card.
+type NudgeResult struct {
+	Candidate Candidate
+	Mode      NudgeMode
+	Comment   string
+	Applied   bool
+	Err       error
+}
+
+// DefaultMessage addresses the card's owners by name and says when the card
+// will be postponed. Comments are HTML, so the names are escaped.
+func DefaultMessage(c Candidate) string {
+	var names []string
+	for _, owner := range c.Owners() {
+		names = append(names, html.EscapeString(owner.Name))
+	}
+
+	var b strings.Builder
+	if len(names) > 0 {
+		b.WriteString(strings.Join(names, ", ") + ": ")
+	}
+	if c.Overdue() {
+		b.WriteString("This card has had no activity for ")
+		fmt.Fprintf(&b, "%d days and will be moved to Not Now shortly.", c.Period)
+	} else {
+		fmt.Fprintf(&b, "This card will be moved to Not Now on %s unless there is activity on it.", c.PostponeAt.Format(time.DateOnly))
+	}
+	return b.String()
+}
+
+// Nudge comments on or bumps each candidate, continuing past failures. The
+// returned error joins every failure.
+func Nudge(ctx context.Context, client *fizzy.Client, candidates []Candidate, opts NudgeOptions) ([]NudgeResult, error) {
+	message := opts.Message
+	if message == nil {
+		message = DefaultMessage
+	}
+
+	results := make([]NudgeResult, 0, len(candidates))
+	var errs []error
+	for _, c := range candidates {
+		result := NudgeResult{Candidate: c, Mode: opts.Mode}
+		if opts.Mode == NudgeComment {
+			result.Comment = message(c)
+		}
+
+		if !opts.DryRun {
+			result.Err = nudge(ctx, client, c, opts.Mode, result.Comment)
+			result.Applied = result.Err == nil
+			if result.Err != nil {
+				errs = append(errs, fmt.Errorf("card #%d: %w", c.Card.Number, result.Err))
+			}
+		}
+		results = append(results, result)
+	}
+
+	return results, errors.Join(errs...)
+}
+
+func nudge(ctx context.Context, client *fizzy.Client, c Candidate, mode NudgeMode, comment string) error {
+	switch mode {
+	case NudgeComment:
+		_, err := client.CreateCardComment(ctx, c.Card.Number, comment)
+		return err
+	case NudgeBump:
+		_, err := client.UpdateCard(ctx, c.Card.Number, fizzy.UpdateCardPayload{
+			LastActiveAt: time.Now().UTC().Format(time.RFC3339),
+		})
+		return err
+	}
+	return fmt.Errorf("unknown nudge mode %q", mode)
+}
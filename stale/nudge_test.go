@@ -0,0 +1,134 @@
+package stale
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	fizzy "github.com/rogeriopvl/fizzy-go"
+)
+
+func TestDefaultMessage(t *testing.T) {
+	c := Candidate{
+		Card:       fizzy.Card{Assignees: []fizzy.User{{ID: "u1", Name: "Ana"}, {ID: "u2", Name: "<b>Bo</b>"}}, Creator: fizzy.User{ID: "u3", Name: "Cy"}},
+		Board:      testBoard,
+		PostponeAt: time.Date(2025, 12, 22, 0, 0, 0, 0, time.UTC),
+		Period:     30,
+		Remaining:  Day,
+	}
+	if got := DefaultMessage(c); !strings.HasPrefix(got, "Ana, &lt;b&gt;Bo&lt;/b&gt;: ") || !strings.Contains(got, "2025-12-22") {
+		t.Errorf("unexpected message: %q", got)
+	}
+
+	c.Card.Assignees = nil
+	c.Remaining = -Day
+	if got := DefaultMessage(c); !strings.HasPrefix(got, "Cy: ") || !strings.Contains(got, "30 days") {
+		t.Errorf("unexpected overdue message: %q", got)
+	}
+}
+
+func TestNudge(t *testing.T) {
+	candidates := Scan(testBoard, testCards, 30, 3*Day, now)
+
+	newServer := func(t *testing.T, requests *[]string) *httptest.Server {
+		var mu sync.Mutex
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var body map[string]map[string]string
+			json.NewDecoder(r.Body).Decode(&body)
+
+			mu.Lock()
+			defer mu.Unlock()
+			switch {
+			case r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, "/comments"):
+				*requests = append(*requests, "comment "+r.URL.Path+" "+body["comment"]["body"])
+				w.Header().Set("Location", r.URL.Path+"/comment-1")
+				w.WriteHeader(http.StatusCreated)
+			case r.Method == http.MethodPut:
+				if body["card"]["last_active_at"] == "" {
+					t.Error("expected last_active_at to be set")
+				}
+				*requests = append(*requests, "bump "+r.URL.Path)
+				json.NewEncoder(w).Encode(fizzy.Card{})
+			default:
+				w.WriteHeader(http.StatusNotFound)
+			}
+		}))
+	}
+
+	t.Run("comments on each card", func(t *testing.T) {
+		var requests []string
+		server := newServer(t, &requests)
+		defer server.Close()
+
+		client, _ := fizzy.NewClient("/test-account", "test-token", fizzy.WithBaseURL(server.URL))
+		results, err := Nudge(context.Background(), client, candidates, NudgeOptions{
+			Mode:    NudgeComment,
+			Message: func(c Candidate) string { return "ping " + c.Card.Title },
+		})
+
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(results) != 2 || !results[0].Applied || results[0].Comment != "ping Overdue" {
+			t.Errorf("unexpected results: %+v", results)
+		}
+		if len(requests) != 2 || requests[0] != "comment /test-account/cards/3/comments ping Overdue" {
+			t.Errorf("unexpected requests: %v", requests)
+		}
+	})
+
+	t.Run("bumps activity", func(t *testing.T) {
+		var requests []string
+		server := newServer(t, &requests)
+		defer server.Close()
+
+		client, _ := fizzy.NewClient("/test-account", "test-token", fizzy.WithBaseURL(server.URL))
+		if _, err := Nudge(context.Background(), client, candidates, NudgeOptions{Mode: NudgeBump}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(requests) != 2 || requests[1] != "bump /test-account/cards/2" {
+			t.Errorf("unexpected requests: %v", requests)
+		}
+	})
+
+	t.Run("makes no requests on dry run", func(t *testing.T) {
+		var requests []string
+		server := newServer(t, &requests)
+		defer server.Close()
+
+		client, _ := fizzy.NewClient("/test-account", "test-token", fizzy.WithBaseURL(server.URL))
+		results, err := Nudge(context.Background(), client, candidates, NudgeOptions{Mode: NudgeComment, DryRun: true})
+
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(requests) != 0 {
+			t.Errorf("expected no requests, got %v", requests)
+		}
+		if len(results) != 2 || results[0].Applied || results[0].Comment == "" {
+			t.Errorf("expected unapplied results with comments, got %+v", results)
+		}
+	})
+
+	t.Run("returns error on failure", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusInternalServerError)
+		}))
+		defer server.Close()
+
+		client, _ := fizzy.NewClient("/test-account", "test-token", fizzy.WithBaseURL(server.URL))
+		results, err := Nudge(context.Background(), client, candidates, NudgeOptions{Mode: NudgeBump})
+
+		if err == nil {
+			t.Error("expected error, got nil")
+		}
+		if len(results) != 2 || results[1].Err == nil {
+			t.Errorf("expected every card to be attempted, got %+v", results)
+		}
+	})
+}
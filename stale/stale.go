@@ -0,0 +1,286 @@
+// Package stale finds cards that are about to be auto-postponed.
+//
+// Fizzy moves an open card to Not Now once it has gone a board's
+// auto-postpone period without activity. The API does not report that
+// period, so callers supply it. Find lists the cards that will get there
+// soon, Simulate shows what a different period would do and Nudge reminds
+// owners or bumps the cards' activity:
+//
+//	candidates, err := stale.Find(ctx, client, stale.Options{Period: 30, Within: 3 * 24 * time.Hour})
+//	stale.WriteText(os.Stdout, candidates)
+//	results, err := stale.Nudge(ctx, client, candidates, stale.NudgeOptions{Mode: stale.NudgeComment})
+package stale
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"sort"
+	"strconv"
+	"text/tabwriter"
+	"time"
+
+	fizzy "github.com/rogeriopvl/fizzy-go"
+)
+
+// Day is the unit of auto-postpone periods.
+const Day = 24 * time.Hour
+
+// ErrNoPeriod is returned when a board's auto-postpone period is neither
+// given nor known.
+var ErrNoPeriod = errors.New("auto-postpone period unknown")
+
+// Candidate is an open card and when it will be auto-postponed.
+type Candidate struct {
+	Card       fizzy.Card
+	Board      fizzy.Board
+	LastActive time.Time
+	PostponeAt time.Time
+	// Period is the auto-postpone period, in days, PostponeAt was worked
+	// out from.
+	Period int
+	// Remaining is the time left until PostponeAt; it is negative for cards
+	// that are overdue and will be postponed on Fizzy's next pass.
+	Remaining time.Duration
+}
+
+// Overdue reports whether the card is already past its board's period.
+func (c Candidate) Overdue() bool {
+	return c.Remaining <= 0
+}
+
+// Owners returns who should hear about the card: its assignees or, for
+// unassigned cards, its creator.
+func (c Candidate) Owners() []fizzy.User {
+	if len(c.Card.Assignees) > 0 {
+		return c.Card.Assignees
+	}
+	if c.Card.Creator.ID == "" {
+		return nil
+	}
+	return []fizzy.User{c.Card.Creator}
+}
+
+// Options controls which cards Find returns.
+type Options struct {
+	// BoardIDs limits the search to these boards. Every board is searched
+	// when it is empty.
+	BoardIDs []string
+
+	// Period is the auto-postpone period, in days, of the searched boards.
+	// It is required unless every board carries its AutoPostponePeriod,
+	// which the API does not return.
+	Period int
+
+	// Within is how close to postponement a card has to be to be listed.
+	// Zero lists only overdue cards.
+	Within time.Duration
+
+	// Now is the time remaining time is measured from. Zero means
+	// time.Now().
+	Now time.Time
+}
+
+// Find lists the open cards of the boards in opts that will be
+// auto-postponed within opts.Within, soonest first. It returns an error
+// wrapping ErrNoPeriod if a board's period is unknown.
+func Find(ctx context.Context, client *fizzy.Client, opts Options) ([]Candidate, error) {
+	boards, cards, err := fetch(ctx, client, opts.BoardIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	now := opts.Now
+	if now.IsZero() {
+		now = time.Now()
+	}
+
+	var candidates []Candidate
+	for _, board := range boards {
+		period, err := boardPeriod(board, opts.Period)
+		if err != nil {
+			return nil, err
+		}
+		candidates = append(candidates, Scan(board, cards[board.ID], period, opts.Within, now)...)
+	}
+	sortCandidates(candidates)
+	return candidates, nil
+}
+
+// Scan returns the cards of board that a period of the given number of days
+// would postpone within the given window of now, soonest first. Closed and
+// already postponed cards, and cards without a known last activity, are
+// ignored. A period of zero or less disables auto-postponement.
+func Scan(board fizzy.Board, cards []fizzy.Card, period int, within time.Duration, now time.Time) []Candidate {
+	if period <= 0 {
+		return nil
+	}
+
+	var candidates []Candidate
+	for _, card := range cards {
+		if card.Closed || card.NotNowAt != "" {
+			continue
+		}
+		lastActive, err := time.Parse(time.RFC3339Nano, card.LastActiveAt)
+		if err != nil {
+			continue
+		}
+
+		postponeAt := lastActive.Add(time.Duration(period) * Day)
+		remaining := postponeAt.Sub(now)
+		if remaining > within {
+			continue
+		}
+		candidates = append(candidates, Candidate{
+			Card:       card,
+			Board:      board,
+			LastActive: lastActive,
+			PostponeAt: postponeAt,
+			Period:     period,
+			Remaining:  remaining,
+		})
+	}
+	sortCandidates(candidates)
+	return candidates
+}
+
+// Simulation compares a board's current auto-postpone period with a
+// proposed one.
+type Simulation struct {
+	Board    fizzy.Board
+	Current  int
+	Proposed int
+
+	// Due lists the cards the proposed period would postpone within the
+	// window, including overdue ones.
+	Due []Candidate
+	// Added lists the cards in Due that the current period would not
+	// postpone within the window.
+	Added []Candidate
+	// Spared lists the cards the current period would postpone within the
+	// window but the proposed one would not.
+	Spared []Candidate
+}
+
+// Postponed returns the cards the proposed period would postpone straight
+// away.
+func (s *Simulation) Postponed() []Candidate {
+	var postponed []Candidate
+	for _, c := range s.Due {
+		if c.Overdue() {
+			postponed = append(postponed, c)
+		}
+	}
+	return postponed
+}
+
+func (s *Simulation) String() string {
+	return fmt.Sprintf("%s: %d -> %d days: %d due (%d at once), %d more, %d spared",
+		s.Board.Name, s.Current, s.Proposed, len(s.Due), len(s.Postponed()), len(s.Added), len(s.Spared))
+}
+
+// Simulate shows the effect of changing board's auto-postpone period from
+// current to proposed days on its cards, looking within the given window of
+// now.
+func Simulate(board fizzy.Board, cards []fizzy.Card, current, proposed int, within time.Duration, now time.Time) *Simulation {
+	before := Scan(board, cards, current, within, now)
+	due := Scan(board, cards, proposed, within, now)
+
+	sim := &Simulation{Board: board, Current: current, Proposed: proposed, Due: due}
+	for _, c := range due {
+		if !containsCard(before, c.Card.ID) {
+			sim.Added = append(sim.Added, c)
+		}
+	}
+	for _, c := range before {
+		if !containsCard(due, c.Card.ID) {
+			sim.Spared = append(sim.Spared, c)
+		}
+	}
+	return sim
+}
+
+// SimulateBoard fetches a board's cards and simulates changing its period
+// from current to proposed days. A zero current period falls back to the
+// board's AutoPostponePeriod and fails with ErrNoPeriod if that is unknown.
+func SimulateBoard(ctx context.Context, client *fizzy.Client, boardID string, current, proposed int, within time.Duration) (*Simulation, error) {
+	boards, cards, err := fetch(ctx, client, []string{boardID})
+	if err != nil {
+		return nil, err
+	}
+	if len(boards) == 0 {
+		return nil, fmt.Errorf("board %s not found", boardID)
+	}
+	current, err = boardPeriod(boards[0], current)
+	if err != nil {
+		return nil, err
+	}
+	return Simulate(boards[0], cards[boardID], current, proposed, within, time.Now()), nil
+}
+
+// boardPeriod returns period or, when it is zero, the board's own period.
+func boardPeriod(board fizzy.Board, period int) (int, error) {
+	if period <= 0 {
+		period = board.AutoPostponePeriod
+	}
+	if period <= 0 {
+		return 0, fmt.Errorf("board %s: %w", board.Name, ErrNoPeriod)
+	}
+	return period, nil
+}
+
+// WriteText writes candidates as an aligned table.
+func WriteText(w io.Writer, candidates []Candidate) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "BOARD\tCARD\tTITLE\tLAST ACTIVE\tPOSTPONED")
+	for _, c := range candidates {
+		fmt.Fprintf(tw, "%s\t#%d\t%s\t%s\t%s\n", c.Board.Name, c.Card.Number, c.Card.Title,
+			c.LastActive.Format(time.DateOnly), describeRemaining(c.Remaining))
+	}
+	return tw.Flush()
+}
+
+func describeRemaining(d time.Duration) string {
+	if d <= 0 {
+		return "overdue"
+	}
+	if d < Day {
+		return "in " + strconv.Itoa(int(d.Hours())) + "h"
+	}
+	return "in " + strconv.Itoa(int(d/Day)) + "d"
+}
+
+func fetch(ctx context.Context, client *fizzy.Client, boardIDs []string) ([]fizzy.Board, map[string][]fizzy.Card, error) {
+	boards, err := client.GetBoards(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list boards: %w", err)
+	}
+	if len(boardIDs) > 0 {
+		boards = slices.DeleteFunc(boards, func(board fizzy.Board) bool {
+			return !slices.Contains(boardIDs, board.ID)
+		})
+	}
+
+	cards, err := client.GetCards(ctx, fizzy.CardFilters{BoardIDs: boardIDs, IndexedBy: "all"})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list cards: %w", err)
+	}
+	byBoard := make(map[string][]fizzy.Card)
+	for _, card := range cards {
+		byBoard[card.Board.ID] = append(byBoard[card.Board.ID], card)
+	}
+
+	return boards, byBoard, nil
+}
+
+func sortCandidates(candidates []Candidate) {
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].PostponeAt.Before(candidates[j].PostponeAt)
+	})
+}
+
+func containsCard(candidates []Candidate, cardID string) bool {
+	return slices.ContainsFunc(candidates, func(c Candidate) bool { return c.Card.ID == cardID })
+}
@@ -0,0 +1,134 @@
+package stale
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	fizzy "github.com/rogeriopvl/fizzy-go"
+)
+
+var now = time.Date(2025, 12, 20, 12, 0, 0, 0, time.UTC)
+
+func activeAt(daysAgo int) string {
+	return now.Add(-time.Duration(daysAgo) * Day).Format(time.RFC3339)
+}
+
+var testBoard = fizzy.Board{ID: "board-1", Name: "Product", AutoPostponePeriod: 30}
+
+var testCards = []fizzy.Card{
+	{ID: "c1", Number: 1, Title: "Fresh", LastActiveAt: activeAt(1), Board: testBoard},
+	{ID: "c2", Number: 2, Title: "Close", LastActiveAt: activeAt(28), Board: testBoard},
+	{ID: "c3", Number: 3, Title: "Overdue", LastActiveAt: activeAt(31), Board: testBoard},
+	{ID: "c4", Number: 4, Title: "Closed", LastActiveAt: activeAt(40), Closed: true, Board: testBoard},
+	{ID: "c5", Number: 5, Title: "Not now", LastActiveAt: activeAt(40), NotNowAt: activeAt(5), Board: testBoard},
+	{ID: "c6", Number: 6, Title: "Middle", LastActiveAt: activeAt(15), Board: testBoard},
+}
+
+func TestScan(t *testing.T) {
+	candidates := Scan(testBoard, testCards, 30, 3*Day, now)
+
+	if len(candidates) != 2 {
+		t.Fatalf("expected 2 candidates, got %d", len(candidates))
+	}
+	if candidates[0].Card.Number != 3 || !candidates[0].Overdue() {
+		t.Errorf("expected overdue card #3 first, got %+v", candidates[0])
+	}
+	if candidates[1].Card.Number != 2 || candidates[1].Remaining != 2*Day {
+		t.Errorf("expected card #2 due in 2 days, got %+v", candidates[1])
+	}
+
+	if got := Scan(testBoard, testCards, 0, 3*Day, now); got != nil {
+		t.Errorf("expected no candidates without a period, got %d", len(got))
+	}
+}
+
+func TestSimulate(t *testing.T) {
+	sim := Simulate(testBoard, testCards, 30, 14, 3*Day, now)
+
+	if sim.Current != 30 || sim.Proposed != 14 {
+		t.Errorf("unexpected periods: %d -> %d", sim.Current, sim.Proposed)
+	}
+	if len(sim.Due) != 3 || len(sim.Postponed()) != 3 {
+		t.Errorf("expected cards #2, #3 and #6 postponed at once, got %+v", sim.Due)
+	}
+	if len(sim.Added) != 1 || sim.Added[0].Card.Number != 6 {
+		t.Errorf("expected card #6 to be added, got %+v", sim.Added)
+	}
+	if len(sim.Spared) != 0 {
+		t.Errorf("expected no spared cards, got %d", len(sim.Spared))
+	}
+
+	longer := Simulate(testBoard, testCards, 30, 60, 3*Day, now)
+	if len(longer.Due) != 0 || len(longer.Spared) != 2 {
+		t.Errorf("expected a longer period to spare 2 cards, got %s", longer)
+	}
+}
+
+func TestFind(t *testing.T) {
+	t.Run("lists cards due under the given period", func(t *testing.T) {
+		mux := http.NewServeMux()
+		mux.HandleFunc("GET /test-account/boards", func(w http.ResponseWriter, r *http.Request) {
+			json.NewEncoder(w).Encode([]fizzy.Board{{ID: "board-1", Name: "Product"}, {ID: "board-2", Name: "Ops"}})
+		})
+		mux.HandleFunc("GET /test-account/cards", func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Query().Get("indexed_by") != "all" {
+				t.Errorf("expected open cards, got %s", r.URL.RawQuery)
+			}
+			cards := append([]fizzy.Card{{ID: "c9", Number: 9, LastActiveAt: activeAt(100), Board: fizzy.Board{ID: "board-2"}}}, testCards...)
+			json.NewEncoder(w).Encode(cards)
+		})
+		server := httptest.NewServer(mux)
+		defer server.Close()
+
+		client, _ := fizzy.NewClient("/test-account", "test-token", fizzy.WithBaseURL(server.URL))
+		candidates, err := Find(context.Background(), client, Options{Period: 30, Within: 3 * Day, Now: now})
+
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(candidates) != 3 || candidates[0].Card.Number != 9 || candidates[1].Board.Name != "Product" {
+			t.Errorf("expected card #9 from Ops and 2 candidates from Product, got %+v", candidates)
+		}
+		if candidates[0].Period != 30 {
+			t.Errorf("expected the given period on candidates, got %d", candidates[0].Period)
+		}
+
+		_, err = Find(context.Background(), client, Options{Within: 3 * Day, Now: now})
+		if !errors.Is(err, ErrNoPeriod) {
+			t.Errorf("expected ErrNoPeriod without a period, got %v", err)
+		}
+	})
+
+	t.Run("returns error on failure", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusInternalServerError)
+		}))
+		defer server.Close()
+
+		client, _ := fizzy.NewClient("/test-account", "test-token", fizzy.WithBaseURL(server.URL))
+		if _, err := Find(context.Background(), client, Options{}); err == nil {
+			t.Error("expected error, got nil")
+		}
+	})
+}
+
+func TestWriteText(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WriteText(&buf, Scan(testBoard, testCards, 30, 3*Day, now)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	out := buf.String()
+	for _, want := range []string{"BOARD", "#3", "overdue", "#2", "in 2d"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected output to contain %q, got:\n%s", want, out)
+		}
+	}
+}
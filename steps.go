@@ -3,7 +3,6 @@ package fizzy
 import (
 	"context"
 	"fmt"
-	"io"
 	"net/http"
 )
 
@@ -25,7 +24,8 @@ func (c *Client) GetCardStep(ctx context.Context, cardNumber int, stepID string)
 }
 
 // CreateCardStep creates a checklist item on a card. Returns a Step with only
-// Content and Completed set, as the API only returns a Location header.
+// ID (when the API provides one), Content and Completed set, as the API only
+// returns a Location header.
 func (c *Client) CreateCardStep(ctx context.Context, cardNumber int, content string, completed bool) (*Step, error) {
 	endpointURL := fmt.Sprintf("%s/cards/%d/steps", c.AccountBaseURL, cardNumber)
 
@@ -41,18 +41,13 @@ func (c *Client) CreateCardStep(ctx context.Context, cardNumber int, content str
 		return nil, fmt.Errorf("failed to create post card step request: %w", err)
 	}
 
-	res, err := c.HTTPClient.Do(req)
+	header, _, err := c.doRequest(req, nil, http.StatusCreated)
 	if err != nil {
-		return nil, fmt.Errorf("failed to make request: %w", err)
-	}
-	defer res.Body.Close()
-
-	if res.StatusCode != http.StatusCreated {
-		body, _ := io.ReadAll(res.Body)
-		return nil, fmt.Errorf("unexpected status code %d: %s", res.StatusCode, string(body))
+		return nil, err
 	}
 
-	return &Step{Content: content, Completed: completed}, nil
+	id, _ := idFromLocation(header.Get("Location"))
+	return &Step{ID: id, Content: content, Completed: completed}, nil
 }
 
 func (c *Client) UpdateCardStep(ctx context.Context, cardNumber int, stepID string, content *string, completed *bool) (*Step, error) {
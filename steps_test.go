@@ -67,6 +67,24 @@ func TestCreateCardStep(t *testing.T) {
 			t.Errorf("expected step content 'Write tests', got '%s'", result.Content)
 		}
 	})
+
+	t.Run("sets ID from Location header", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Location", "/test-account/cards/42/steps/step-5.json")
+			w.WriteHeader(http.StatusCreated)
+		}))
+		defer server.Close()
+
+		client, _ := NewClient("/test-account", "test-token", WithBaseURL(server.URL))
+		result, err := client.CreateCardStep(context.Background(), 42, "Write tests", false)
+
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if result.ID != "step-5" {
+			t.Errorf("expected step ID 'step-5', got '%s'", result.ID)
+		}
+	})
 }
 
 func TestUpdateCardStep(t *testing.T) {
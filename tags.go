@@ -14,11 +14,5 @@ func (c *Client) GetTags(ctx context.Context) ([]Tag, error) {
 		return nil, fmt.Errorf("failed to create get tags request: %w", err)
 	}
 
-	var response []Tag
-	_, err = c.decodeResponse(req, &response)
-	if err != nil {
-		return nil, err
-	}
-
-	return response, nil
+	return getAllPages[Tag](c, req)
 }
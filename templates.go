@@ -0,0 +1,253 @@
+package fizzy
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/rogeriopvl/fizzy-go/internal/shared"
+	"gopkg.in/yaml.v3"
+)
+
+// BoardTemplate describes a board's structure declaratively so it can be kept
+// in version control and stamped out repeatedly. Templates are read from YAML
+// or JSON with ParseBoardTemplate and produced from live boards with
+// GetBoardTemplate. UserIDs are given access to boards created with
+// AllAccess false.
+type BoardTemplate struct {
+	Name               string           `json:"name" yaml:"name"`
+	AllAccess          *bool            `json:"all_access,omitempty" yaml:"all_access,omitempty"`
+	UserIDs            []string         `json:"user_ids,omitempty" yaml:"user_ids,omitempty"`
+	AutoPostponePeriod int              `json:"auto_postpone_period,omitempty" yaml:"auto_postpone_period,omitempty"`
+	PublicDescription  string           `json:"public_description,omitempty" yaml:"public_description,omitempty"`
+	Columns            []ColumnTemplate `json:"columns" yaml:"columns"`
+	Cards              []CardTemplate   `json:"cards,omitempty" yaml:"cards,omitempty"`
+}
+
+// ColumnTemplate is a column in a BoardTemplate. Color is a color name such
+// as "Lime" or a CSS value accepted by ParseColor; empty uses the default.
+type ColumnTemplate struct {
+	Name  string `json:"name" yaml:"name"`
+	Color string `json:"color,omitempty" yaml:"color,omitempty"`
+}
+
+// CardTemplate is a card seeded on boards created from a template. Column is
+// the name of the column to place it in; empty leaves it in triage.
+type CardTemplate struct {
+	Title       string         `json:"title" yaml:"title"`
+	Description string         `json:"description,omitempty" yaml:"description,omitempty"`
+	Column      string         `json:"column,omitempty" yaml:"column,omitempty"`
+	Tags        []string       `json:"tags,omitempty" yaml:"tags,omitempty"`
+	Steps       []StepTemplate `json:"steps,omitempty" yaml:"steps,omitempty"`
+}
+
+type StepTemplate struct {
+	Content   string `json:"content" yaml:"content"`
+	Completed bool   `json:"completed,omitempty" yaml:"completed,omitempty"`
+}
+
+// BoardTemplateOptions controls which cards GetBoardTemplate and CloneBoard
+// copy from the source board.
+type BoardTemplateOptions struct {
+	// IncludeCards copies the source board's cards, with their steps and
+	// tags, as seed cards.
+	IncludeCards bool
+
+	// CardFilters narrows the copied cards, e.g. to those tagged "template".
+	// BoardIDs is always set to the source board.
+	CardFilters CardFilters
+}
+
+// ParseBoardTemplate decodes a template from YAML or JSON and validates it.
+func ParseBoardTemplate(data []byte) (*BoardTemplate, error) {
+	var tmpl BoardTemplate
+	if err := yaml.Unmarshal(data, &tmpl); err != nil {
+		return nil, fmt.Errorf("failed to decode board template: %w", err)
+	}
+	if err := tmpl.Validate(); err != nil {
+		return nil, err
+	}
+	return &tmpl, nil
+}
+
+// Validate checks that every column has a unique name and a known color,
+// and that seed cards only reference columns defined by the template.
+func (t *BoardTemplate) Validate() error {
+	columns := make(map[string]bool, len(t.Columns))
+	for i, column := range t.Columns {
+		if column.Name == "" {
+			return fmt.Errorf("board template: column %d has no name", i+1)
+		}
+		if columns[column.Name] {
+			return fmt.Errorf("board template: duplicate column %q", column.Name)
+		}
+		if column.Color != "" {
+			if _, ok := ParseColor(column.Color); !ok {
+				return fmt.Errorf("board template: column %q has unknown color %q", column.Name, column.Color)
+			}
+		}
+		columns[column.Name] = true
+	}
+
+	for i, card := range t.Cards {
+		if card.Title == "" {
+			return fmt.Errorf("board template: card %d has no title", i+1)
+		}
+		if card.Column != "" && !columns[card.Column] {
+			return fmt.Errorf("board template: card %q references unknown column %q", card.Title, card.Column)
+		}
+	}
+
+	return nil
+}
+
+// GetBoardTemplate captures the structure of an existing board: whether it
+// is open to all users, its columns in order and, optionally, its cards.
+// Column colors outside the palette are left out, so the columns get the
+// default color when the template is applied. The board payload carries
+// neither the auto-postpone period nor the access list of a restricted
+// board, so those are left out too; a restricted board's template gives
+// access to no one but the creator of the new board until UserIDs is set.
+func (c *Client) GetBoardTemplate(ctx context.Context, boardID string, opts BoardTemplateOptions) (*BoardTemplate, error) {
+	board, err := c.GetBoard(ctx, boardID)
+	if err != nil {
+		return nil, err
+	}
+
+	columns, err := c.ForBoard(boardID).GetColumns(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	allAccess := board.AllAccess
+	tmpl := &BoardTemplate{
+		Name:      board.Name,
+		AllAccess: &allAccess,
+		Columns:   make([]ColumnTemplate, 0, len(columns)),
+	}
+	for _, column := range columns {
+		seed := ColumnTemplate{Name: column.Name}
+		if color, ok := ParseColor(string(column.Color.Value)); ok {
+			seed.Color = color.Name()
+		}
+		tmpl.Columns = append(tmpl.Columns, seed)
+	}
+
+	if !opts.IncludeCards {
+		return tmpl, nil
+	}
+
+	filters := opts.CardFilters
+	filters.BoardIDs = []string{boardID}
+	cards, err := c.GetCards(ctx, filters)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, summary := range cards {
+		// Steps are only included when fetching a single card.
+		card, err := c.GetCard(ctx, summary.Number)
+		if err != nil {
+			return nil, err
+		}
+
+		seed := CardTemplate{
+			Title:       card.Title,
+			Description: card.DescriptionHTML,
+			Tags:        card.Tags,
+		}
+		if card.Column != nil {
+			seed.Column = card.Column.Name
+		}
+		for _, step := range card.Steps {
+			seed.Steps = append(seed.Steps, StepTemplate{Content: step.Content, Completed: step.Completed})
+		}
+		tmpl.Cards = append(tmpl.Cards, seed)
+	}
+
+	return tmpl, nil
+}
+
+// CreateBoardFromTemplate creates a board from tmpl, named name (or the
+// template's name when empty), with its columns and seed cards. It returns
+// the new board's ID.
+func (c *Client) CreateBoardFromTemplate(ctx context.Context, tmpl *BoardTemplate, name string) (string, error) {
+	if err := tmpl.Validate(); err != nil {
+		return "", err
+	}
+	if name == "" {
+		name = tmpl.Name
+	}
+
+	payload := CreateBoardPayload{
+		Name:               name,
+		AllAccess:          tmpl.AllAccess == nil || *tmpl.AllAccess,
+		AutoPostponePeriod: tmpl.AutoPostponePeriod,
+		PublicDescription:  tmpl.PublicDescription,
+	}
+	boardID, err := c.CreateBoardAndGetID(ctx, payload)
+	if err != nil {
+		return "", fmt.Errorf("failed to create board %q: %w", name, err)
+	}
+	if !payload.AllAccess && len(tmpl.UserIDs) > 0 {
+		if err := c.SetBoardAccess(ctx, boardID, tmpl.UserIDs); err != nil {
+			return boardID, fmt.Errorf("failed to give access to board %q: %w", name, err)
+		}
+	}
+
+	board := c.ForBoard(boardID)
+
+	columnIDs := make(map[string]string, len(tmpl.Columns))
+	for _, column := range tmpl.Columns {
+		columnPayload := CreateColumnPayload{Name: column.Name}
+		if column.Color != "" {
+			color, _ := ParseColor(column.Color)
+			columnPayload.Color = &color
+		}
+
+		id, err := board.CreateColumnAndGetID(ctx, columnPayload)
+		if err != nil {
+			return boardID, fmt.Errorf("failed to create column %q: %w", column.Name, err)
+		}
+		columnIDs[column.Name] = id
+	}
+
+	for _, seed := range tmpl.Cards {
+		number, err := board.CreateCardAndGetNumber(ctx, CreateCardPayload{Title: seed.Title, Description: seed.Description})
+		if err != nil {
+			return boardID, fmt.Errorf("failed to create card %q: %w", seed.Title, err)
+		}
+		tagged := make(map[string]bool, len(seed.Tags))
+		for _, tag := range seed.Tags {
+			if tagged[shared.TagKey(tag)] {
+				continue
+			}
+			tagged[shared.TagKey(tag)] = true
+			if err := c.TagCard(ctx, number, tag); err != nil {
+				return boardID, err
+			}
+		}
+		for _, step := range seed.Steps {
+			if _, err := c.CreateCardStep(ctx, number, step.Content, step.Completed); err != nil {
+				return boardID, err
+			}
+		}
+		if seed.Column != "" {
+			if err := c.TriageCard(ctx, number, columnIDs[seed.Column]); err != nil {
+				return boardID, err
+			}
+		}
+	}
+
+	return boardID, nil
+}
+
+// CloneBoard recreates the structure of the board sourceBoardID under a new
+// name and returns the new board's ID. See GetBoardTemplate for what is
+// copied.
+func (c *Client) CloneBoard(ctx context.Context, sourceBoardID string, name string, opts BoardTemplateOptions) (string, error) {
+	tmpl, err := c.GetBoardTemplate(ctx, sourceBoardID, opts)
+	if err != nil {
+		return "", err
+	}
+	return c.CreateBoardFromTemplate(ctx, tmpl, name)
+}
@@ -0,0 +1,228 @@
+package fizzy
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestParseColor(t *testing.T) {
+	if c, ok := ParseColor("lime"); !ok || c != ColorLime {
+		t.Errorf("expected 'lime' to parse as ColorLime, got %q", c)
+	}
+	if c, ok := ParseColor("var(--color-card-8)"); !ok || c != ColorPink {
+		t.Errorf("expected CSS value to parse as ColorPink, got %q", c)
+	}
+	if _, ok := ParseColor("orange"); ok {
+		t.Error("expected unknown color to be rejected")
+	}
+	if ColorAqua.Name() != "Aqua" {
+		t.Errorf("expected name 'Aqua', got '%s'", ColorAqua.Name())
+	}
+}
+
+func TestParseBoardTemplate(t *testing.T) {
+	t.Run("parses YAML", func(t *testing.T) {
+		tmpl, err := ParseBoardTemplate([]byte(`
+name: Project
+all_access: false
+auto_postpone_period: 14
+columns:
+  - name: Doing
+    color: Lime
+  - name: Review
+cards:
+  - title: Kickoff
+    column: Doing
+    tags: [meeting]
+    steps:
+      - content: Book room
+`))
+
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if tmpl.AllAccess == nil || *tmpl.AllAccess {
+			t.Error("expected all_access false")
+		}
+		if len(tmpl.Columns) != 2 || tmpl.Columns[0].Color != "Lime" {
+			t.Errorf("unexpected columns: %+v", tmpl.Columns)
+		}
+		if len(tmpl.Cards) != 1 || tmpl.Cards[0].Steps[0].Content != "Book room" {
+			t.Errorf("unexpected cards: %+v", tmpl.Cards)
+		}
+	})
+
+	t.Run("parses JSON", func(t *testing.T) {
+		tmpl, err := ParseBoardTemplate([]byte(`{"name": "Project", "columns": [{"name": "Doing"}]}`))
+
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if tmpl.Name != "Project" || len(tmpl.Columns) != 1 {
+			t.Errorf("unexpected template: %+v", tmpl)
+		}
+	})
+
+	t.Run("rejects unknown colors", func(t *testing.T) {
+		_, err := ParseBoardTemplate([]byte("name: P\ncolumns:\n  - name: Doing\n    color: Orange\n"))
+		if err == nil {
+			t.Fatal("expected error, got nil")
+		}
+	})
+
+	t.Run("rejects duplicate columns", func(t *testing.T) {
+		_, err := ParseBoardTemplate([]byte("name: P\ncolumns:\n  - name: Doing\n  - name: Doing\n"))
+		if err == nil {
+			t.Fatal("expected error, got nil")
+		}
+	})
+
+	t.Run("rejects cards in unknown columns", func(t *testing.T) {
+		_, err := ParseBoardTemplate([]byte("name: P\ncolumns: []\ncards:\n  - title: A\n    column: Nope\n"))
+		if err == nil {
+			t.Fatal("expected error, got nil")
+		}
+	})
+}
+
+func TestCloneBoard(t *testing.T) {
+	var mutations []string
+	var bodies []map[string]any
+	nextCard := 200
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		if r.Method == http.MethodGet {
+			switch r.URL.Path {
+			case "/test-account/boards/src":
+				json.NewEncoder(w).Encode(Board{ID: "src", Name: "Template", AllAccess: false})
+			case "/test-account/boards/src/columns":
+				json.NewEncoder(w).Encode([]Column{
+					{ID: "c1", Name: "Doing", Color: ColorObject{Name: "Lime", Value: ColorLime}},
+					{ID: "c2", Name: "Review"},
+					{ID: "c3", Name: "Archive", Color: ColorObject{Value: "#ff0000"}},
+				})
+			case "/test-account/cards":
+				if r.URL.Query().Get("board_ids[]") != "src" {
+					t.Errorf("expected cards to be filtered by source board, got %s", r.URL.RawQuery)
+				}
+				json.NewEncoder(w).Encode([]Card{{Number: 5}})
+			case "/test-account/cards/5":
+				json.NewEncoder(w).Encode(Card{
+					Number: 5,
+					Title:  "Checklist",
+					Tags:   []string{"ops"},
+					Column: &Column{ID: "c2", Name: "Review"},
+					Steps:  []Step{{Content: "Verify", Completed: true}},
+				})
+			default:
+				t.Errorf("unexpected GET %s", r.URL.Path)
+			}
+			return
+		}
+
+		var body map[string]any
+		json.NewDecoder(r.Body).Decode(&body)
+		mutations = append(mutations, r.Method+" "+strings.TrimPrefix(r.URL.Path, "/test-account"))
+		bodies = append(bodies, body)
+
+		switch {
+		case r.URL.Path == "/test-account/boards":
+			w.Header().Set("Location", "/test-account/boards/dst.json")
+			w.WriteHeader(http.StatusCreated)
+		case strings.HasSuffix(r.URL.Path, "/columns"):
+			w.Header().Set("Location", fmt.Sprintf("/test-account/boards/dst/columns/new-%d.json", len(mutations)))
+			w.WriteHeader(http.StatusCreated)
+		case strings.HasSuffix(r.URL.Path, "/boards/dst/cards"):
+			nextCard++
+			w.Header().Set("Location", fmt.Sprintf("/test-account/cards/%d", nextCard))
+			w.WriteHeader(http.StatusCreated)
+		case strings.HasSuffix(r.URL.Path, "/steps"):
+			w.WriteHeader(http.StatusCreated)
+		default:
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+	defer server.Close()
+
+	client, _ := NewClient("/test-account", "test-token", WithBaseURL(server.URL))
+	boardID, err := client.CloneBoard(context.Background(), "src", "Project X", BoardTemplateOptions{IncludeCards: true})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if boardID != "dst" {
+		t.Errorf("expected new board ID 'dst', got '%s'", boardID)
+	}
+
+	expected := []string{
+		"POST /boards",
+		"POST /boards/dst/columns",
+		"POST /boards/dst/columns",
+		"POST /boards/dst/columns",
+		"POST /boards/dst/cards",
+		"POST /cards/201/taggings",
+		"POST /cards/201/steps",
+		"POST /cards/201/triage",
+	}
+	if strings.Join(mutations, "\n") != strings.Join(expected, "\n") {
+		t.Fatalf("unexpected calls:\n%s", strings.Join(mutations, "\n"))
+	}
+
+	board := bodies[0]["board"].(map[string]any)
+	if board["name"] != "Project X" || board["all_access"] != false {
+		t.Errorf("unexpected board payload: %v", board)
+	}
+	if period, ok := board["auto_postpone_period"]; ok {
+		t.Errorf("expected no auto_postpone_period, got %v", period)
+	}
+	if color := bodies[1]["column"].(map[string]any)["color"]; color != string(ColorLime) {
+		t.Errorf("expected column color to be copied, got %v", color)
+	}
+	if color, ok := bodies[3]["column"].(map[string]any)["color"]; ok {
+		t.Errorf("expected color outside the palette to be left out, got %v", color)
+	}
+	if column := bodies[7]["column_id"]; column != "new-3" {
+		t.Errorf("expected card to be triaged into the cloned 'Review' column, got %v", column)
+	}
+}
+
+func TestCreateBoardFromTemplateAccess(t *testing.T) {
+	var mutations []string
+	var access map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mutations = append(mutations, r.Method+" "+strings.TrimPrefix(r.URL.Path, "/test-account"))
+		if r.Method == http.MethodPut {
+			var body map[string]map[string]any
+			json.NewDecoder(r.Body).Decode(&body)
+			access = body["board"]
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		w.Header().Set("Location", "/test-account/boards/dst.json")
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer server.Close()
+
+	client, _ := NewClient("/test-account", "test-token", WithBaseURL(server.URL))
+	tmpl, err := ParseBoardTemplate([]byte("name: Private\nall_access: false\nuser_ids: [user-1, user-2]\ncolumns: []\n"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if _, err := client.CreateBoardFromTemplate(context.Background(), tmpl, ""); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if strings.Join(mutations, ",") != "POST /boards,PUT /boards/dst" {
+		t.Errorf("unexpected calls: %v", mutations)
+	}
+	if fmt.Sprint(access["user_ids"]) != "[user-1 user-2]" {
+		t.Errorf("expected access for both users, got %v", access)
+	}
+}
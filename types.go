@@ -1,18 +1,25 @@
 package fizzy
 
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
 type Board struct {
-	ID        string `json:"id"`
-	Name      string `json:"name"`
-	AllAccess bool   `json:"all_access"`
-	CreatedAt string `json:"created_at"`
-	URL       string `json:"url"`
-	Creator   User   `json:"creator"`
+	ID                 string `json:"id"`
+	Name               string `json:"name"`
+	AllAccess          bool   `json:"all_access"`
+	AutoPostponePeriod int    `json:"auto_postpone_period,omitempty"`
+	CreatedAt          string `json:"created_at"`
+	URL                string `json:"url"`
+	Creator            User   `json:"creator"`
 }
 
 type CreateBoardPayload struct {
 	Name               string `json:"name"`
 	AllAccess          bool   `json:"all_access"`
-	AutoPostponePeriod int    `json:"auto_postpone_period"`
+	AutoPostponePeriod int    `json:"auto_postpone_period,omitempty"`
 	PublicDescription  string `json:"public_description"`
 }
 
@@ -21,6 +28,9 @@ type UpdateBoardPayload struct {
 	AllAccess          *bool  `json:"all_access,omitempty"`
 	AutoPostponePeriod *int   `json:"auto_postpone_period,omitempty"`
 	PublicDescription  string `json:"public_description,omitempty"`
+	// UserIDs lists *all* users who should have access to the board. Only
+	// applies when AllAccess is false.
+	UserIDs []string `json:"user_ids,omitempty"`
 }
 
 type Column struct {
@@ -28,6 +38,8 @@ type Column struct {
 	Name      string      `json:"name"`
 	Color     ColorObject `json:"color"`
 	CreatedAt string      `json:"created_at"`
+	// Position is the column's place on its board, when the API reports it.
+	Position int `json:"position,omitempty"`
 }
 
 type ColorObject struct {
@@ -62,8 +74,52 @@ type Card struct {
 	Board           Board    `json:"board"`
 	Column          *Column  `json:"column,omitempty"`
 	Creator         User     `json:"creator"`
+	Assignees       []User   `json:"assignees,omitempty"`
 	CommentsURL     string   `json:"comments_url"`
 	Steps           []Step   `json:"steps,omitempty"`
+
+	// TagDetails holds the card's tags with their IDs when the API returns
+	// tag objects rather than titles. Tags always holds the titles.
+	TagDetails []Tag `json:"tag_details,omitempty"`
+
+	Watchers      []User `json:"watchers,omitempty"`
+	Closer        *User  `json:"closer,omitempty"`
+	ClosedAt      string `json:"closed_at,omitempty"`
+	NotNowAt      string `json:"not_now_at,omitempty"`
+	Position      int    `json:"position,omitempty"`
+	CommentsCount int    `json:"comments_count,omitempty"`
+}
+
+// UnmarshalJSON accepts tags either as titles or as tag objects, filling
+// Tags with the titles and, for objects, TagDetails with the full tags.
+func (card *Card) UnmarshalJSON(data []byte) error {
+	type plainCard Card
+	var raw struct {
+		plainCard
+		Tags json.RawMessage `json:"tags"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	*card = Card(raw.plainCard)
+
+	if len(raw.Tags) == 0 || string(raw.Tags) == "null" {
+		return nil
+	}
+	if err := json.Unmarshal(raw.Tags, &card.Tags); err == nil {
+		return nil
+	}
+
+	var tags []Tag
+	if err := json.Unmarshal(raw.Tags, &tags); err != nil {
+		return fmt.Errorf("failed to decode card tags: %w", err)
+	}
+	card.TagDetails = tags
+	card.Tags = make([]string, len(tags))
+	for i, tag := range tags {
+		card.Tags[i] = tag.Title
+	}
+	return nil
 }
 
 type CardFilters struct {
@@ -190,6 +246,38 @@ const (
 	ColorPink   Color = "var(--color-card-8)"
 )
 
+var colorNames = map[Color]string{
+	ColorBlue:   "Blue",
+	ColorGray:   "Gray",
+	ColorTan:    "Tan",
+	ColorYellow: "Yellow",
+	ColorLime:   "Lime",
+	ColorAqua:   "Aqua",
+	ColorViolet: "Violet",
+	ColorPurple: "Purple",
+	ColorPink:   "Pink",
+}
+
+// Name returns the color's display name, such as "Lime", or the raw value
+// for colors outside the palette.
+func (c Color) Name() string {
+	if name, ok := colorNames[c]; ok {
+		return name
+	}
+	return string(c)
+}
+
+// ParseColor accepts either a color name ("lime", case-insensitive) or its
+// CSS value ("var(--color-card-4)").
+func ParseColor(s string) (Color, bool) {
+	for color, name := range colorNames {
+		if strings.EqualFold(s, name) || s == string(color) {
+			return color, true
+		}
+	}
+	return "", false
+}
+
 func AllColors() []Color {
 	return []Color{
 		ColorBlue,
@@ -3,6 +3,7 @@ package fizzy
 import (
 	"context"
 	"fmt"
+	"io"
 	"net/http"
 )
 
@@ -14,13 +15,7 @@ func (c *Client) GetUsers(ctx context.Context) ([]User, error) {
 		return nil, fmt.Errorf("failed to create get users request: %w", err)
 	}
 
-	var response []User
-	_, err = c.decodeResponse(req, &response)
-	if err != nil {
-		return nil, err
-	}
-
-	return response, nil
+	return getAllPages[User](c, req)
 }
 
 func (c *Client) GetUser(ctx context.Context, userID string) (*User, error) {
@@ -77,3 +72,50 @@ func (c *Client) DeactivateUser(ctx context.Context, userID string) error {
 
 	return nil
 }
+
+// User roles as reported in User.Role.
+const (
+	RoleOwner  = "owner"
+	RoleAdmin  = "admin"
+	RoleMember = "member"
+)
+
+// IsAdmin reports whether the user can administer the account.
+func (u User) IsAdmin() bool {
+	return u.Role == RoleOwner || u.Role == RoleAdmin
+}
+
+// SetUserRole makes a user an admin or a regular member. The owner's role
+// cannot be changed.
+func (c *Client) SetUserRole(ctx context.Context, userID string, role string) error {
+	if role != RoleAdmin && role != RoleMember {
+		return fmt.Errorf("invalid role %q: must be %q or %q", role, RoleAdmin, RoleMember)
+	}
+
+	endpointURL := c.AccountBaseURL + "/users/" + userID + "/role"
+
+	body := map[string]map[string]string{"user": {"role": role}}
+
+	req, err := c.newRequest(ctx, http.MethodPut, endpointURL, body)
+	if err != nil {
+		return fmt.Errorf("failed to create update user role request: %w", err)
+	}
+
+	_, err = c.decodeResponse(req, nil, http.StatusNoContent)
+	return err
+}
+
+// UpdateUserAvatar uploads a new avatar image for a user. The API accepts
+// JPEG, PNG, GIF and WebP images; filename's extension is used to tell them
+// apart.
+func (c *Client) UpdateUserAvatar(ctx context.Context, userID string, filename string, image io.Reader) error {
+	endpointURL := c.AccountBaseURL + "/users/" + userID
+
+	req, err := c.newMultipartRequest(ctx, http.MethodPut, endpointURL, "user[avatar]", filename, image)
+	if err != nil {
+		return fmt.Errorf("failed to create update user avatar request: %w", err)
+	}
+
+	_, err = c.decodeResponse(req, nil, http.StatusNoContent)
+	return err
+}
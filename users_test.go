@@ -3,8 +3,10 @@ package fizzy
 import (
 	"context"
 	"encoding/json"
+	"io"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 )
 
@@ -114,3 +116,79 @@ func TestDeactivateUser(t *testing.T) {
 		}
 	})
 }
+
+func TestSetUserRole(t *testing.T) {
+	t.Run("updates role", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodPut {
+				t.Errorf("expected PUT, got %s", r.Method)
+			}
+			if r.URL.Path != "/test-account/users/user-1/role" {
+				t.Errorf("unexpected path: %s", r.URL.Path)
+			}
+
+			var body map[string]map[string]string
+			json.NewDecoder(r.Body).Decode(&body)
+			if body["user"]["role"] != "admin" {
+				t.Errorf("expected role 'admin', got '%s'", body["user"]["role"])
+			}
+
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		defer server.Close()
+
+		client, _ := NewClient("/test-account", "test-token", WithBaseURL(server.URL))
+		err := client.SetUserRole(context.Background(), "user-1", RoleAdmin)
+
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("rejects other roles", func(t *testing.T) {
+		client, _ := NewClient("/test-account", "test-token")
+		err := client.SetUserRole(context.Background(), "user-1", RoleOwner)
+
+		if err == nil {
+			t.Fatal("expected error, got nil")
+		}
+	})
+}
+
+func TestUpdateUserAvatar(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut {
+			t.Errorf("expected PUT, got %s", r.Method)
+		}
+		if r.Header.Get("Authorization") != "Bearer test-token" {
+			t.Errorf("unexpected Authorization header: %s", r.Header.Get("Authorization"))
+		}
+
+		file, header, err := r.FormFile("user[avatar]")
+		if err != nil {
+			t.Fatalf("expected avatar upload: %v", err)
+		}
+		data, _ := io.ReadAll(file)
+		if header.Filename != "me.png" || string(data) != "png-bytes" {
+			t.Errorf("unexpected upload %s: %q", header.Filename, data)
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client, _ := NewClient("/test-account", "test-token", WithBaseURL(server.URL))
+	err := client.UpdateUserAvatar(context.Background(), "user-1", "me.png", strings.NewReader("png-bytes"))
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestUserIsAdmin(t *testing.T) {
+	for role, expected := range map[string]bool{RoleOwner: true, RoleAdmin: true, RoleMember: false} {
+		if (User{Role: role}).IsAdmin() != expected {
+			t.Errorf("expected IsAdmin %t for role %s", expected, role)
+		}
+	}
+}
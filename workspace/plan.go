@@ -0,0 +1,480 @@
+package workspace
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	fizzy "github.com/rogeriopvl/fizzy-go"
+)
+
+// ErrDestructive is returned by Apply when the plan deletes something or
+// removes a user's access to a board and destructive changes were not
+// allowed.
+var ErrDestructive = errors.New("plan contains destructive changes")
+
+// Action is what a Change does.
+type Action string
+
+const (
+	ActionCreate  Action = "create"
+	ActionUpdate  Action = "update"
+	ActionDelete  Action = "delete"
+	ActionReorder Action = "reorder"
+)
+
+// Kind is the type of object a Change applies to.
+type Kind string
+
+const (
+	KindBoard  Kind = "board"
+	KindColumn Kind = "column"
+	KindAccess Kind = "access"
+	KindTag    Kind = "tag"
+)
+
+// Change is a single step of a Plan.
+type Change struct {
+	Action Action
+	Kind   Kind
+	Board  string
+	Name   string
+	Detail string
+
+	// Manual is set for changes Apply cannot carry out through the API;
+	// they are reported so they can be done by hand.
+	Manual bool
+
+	// Destructive is set for changes that delete something or remove a
+	// user's access to a board.
+	Destructive bool
+
+	apply func(ctx context.Context) error
+}
+
+func (ch Change) String() string {
+	symbol := map[Action]string{ActionCreate: "+", ActionUpdate: "~", ActionDelete: "-", ActionReorder: "~"}[ch.Action]
+	if ch.Manual {
+		symbol = "!"
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s %s %s", symbol, ch.Action, ch.Kind)
+	if ch.Name != "" {
+		fmt.Fprintf(&b, " %q", ch.Name)
+	}
+	if ch.Board != "" && ch.Kind != KindBoard {
+		fmt.Fprintf(&b, " on board %q", ch.Board)
+	}
+	if ch.Detail != "" {
+		b.WriteString(": " + ch.Detail)
+	}
+	if ch.Manual {
+		b.WriteString(" (manual)")
+	}
+	return b.String()
+}
+
+// Plan is the ordered list of changes that brings an account to the state
+// described by a Config.
+type Plan struct {
+	Changes []Change
+}
+
+// Empty reports whether the account already matches the config.
+func (p *Plan) Empty() bool {
+	return len(p.Changes) == 0
+}
+
+// Destructive reports whether the plan deletes anything or removes
+// anyone's access to a board.
+func (p *Plan) Destructive() bool {
+	for _, ch := range p.Changes {
+		if ch.Destructive {
+			return true
+		}
+	}
+	return false
+}
+
+// String renders the plan one change per line, prefixed with + for
+// creates, ~ for updates, - for deletes and ! for manual changes.
+func (p *Plan) String() string {
+	if p.Empty() {
+		return "No changes.\n"
+	}
+	var b strings.Builder
+	for _, ch := range p.Changes {
+		b.WriteString(ch.String() + "\n")
+	}
+	return b.String()
+}
+
+func (p *Plan) add(ch Change) {
+	p.Changes = append(p.Changes, ch)
+}
+
+// boardRef lets changes for a board that does not exist yet find its ID once
+// an earlier change in the plan has created it.
+type boardRef struct {
+	id string
+}
+
+// Diff compares cfg with the live account and returns the changes needed to
+// converge. It does not modify anything.
+func Diff(ctx context.Context, client *fizzy.Client, cfg *Config) (*Plan, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+
+	boards, err := client.GetBoards(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list boards: %w", err)
+	}
+	live := make(map[string]fizzy.Board, len(boards))
+	for _, board := range boards {
+		live[board.Name] = board
+	}
+
+	userIDs, err := resolveUsers(ctx, client, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	plan := &Plan{}
+	for _, spec := range cfg.Boards {
+		board, exists := live[spec.Name]
+		if !exists {
+			planNewBoard(plan, client, spec, userIDs)
+			continue
+		}
+		if err := planBoard(ctx, plan, client, spec, board, userIDs); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(cfg.Tags) > 0 {
+		tags, err := client.GetTags(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list tags: %w", err)
+		}
+		existing := make(map[string]bool, len(tags))
+		for _, tag := range tags {
+			existing[strings.ToLower(tag.Title)] = true
+		}
+		for _, title := range cfg.Tags {
+			if !existing[strings.ToLower(title)] {
+				plan.add(Change{
+					Action: ActionCreate,
+					Kind:   KindTag,
+					Name:   title,
+					Detail: "tags are created by tagging a card",
+					Manual: true,
+				})
+			}
+		}
+	}
+
+	return plan, nil
+}
+
+func resolveUsers(ctx context.Context, client *fizzy.Client, cfg *Config) (map[string]string, error) {
+	needed := false
+	for _, spec := range cfg.Boards {
+		needed = needed || len(spec.Users) > 0
+	}
+	if !needed {
+		return nil, nil
+	}
+
+	users, err := client.GetUsers(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list users: %w", err)
+	}
+	ids := make(map[string]string, len(users))
+	for _, u := range users {
+		ids[strings.ToLower(u.Email)] = u.ID
+	}
+
+	for _, spec := range cfg.Boards {
+		for _, email := range spec.Users {
+			if _, ok := ids[strings.ToLower(email)]; !ok {
+				return nil, fmt.Errorf("board %q grants access to unknown user %q", spec.Name, email)
+			}
+		}
+	}
+	return ids, nil
+}
+
+func accessUserIDs(spec BoardSpec, userIDs map[string]string) []string {
+	ids := make([]string, 0, len(spec.Users))
+	for _, email := range spec.Users {
+		ids = append(ids, userIDs[strings.ToLower(email)])
+	}
+	return ids
+}
+
+func planNewBoard(plan *Plan, client *fizzy.Client, spec BoardSpec, userIDs map[string]string) {
+	ref := &boardRef{}
+
+	payload := fizzy.CreateBoardPayload{
+		Name:      spec.Name,
+		AllAccess: spec.AllAccess == nil || *spec.AllAccess,
+	}
+	if spec.AutoPostponePeriod != nil {
+		payload.AutoPostponePeriod = *spec.AutoPostponePeriod
+	}
+	plan.add(Change{
+		Action: ActionCreate,
+		Kind:   KindBoard,
+		Board:  spec.Name,
+		Name:   spec.Name,
+		apply: func(ctx context.Context) error {
+			id, err := client.CreateBoardAndGetID(ctx, payload)
+			ref.id = id
+			return err
+		},
+	})
+
+	if !payload.AllAccess && len(spec.Users) > 0 {
+		planAccess(plan, client, spec, ref, userIDs, false)
+	}
+
+	for _, column := range spec.Columns {
+		planNewColumn(plan, client, spec.Name, ref, column)
+	}
+}
+
+func planBoard(ctx context.Context, plan *Plan, client *fizzy.Client, spec BoardSpec, board fizzy.Board, userIDs map[string]string) error {
+	ref := &boardRef{id: board.ID}
+
+	var update fizzy.UpdateBoardPayload
+	var details []string
+	if spec.AllAccess != nil && *spec.AllAccess != board.AllAccess {
+		update.AllAccess = spec.AllAccess
+		details = append(details, fmt.Sprintf("all_access %t -> %t", board.AllAccess, *spec.AllAccess))
+	}
+	if spec.AutoPostponePeriod != nil && *spec.AutoPostponePeriod != board.AutoPostponePeriod {
+		update.AutoPostponePeriod = spec.AutoPostponePeriod
+		details = append(details, fmt.Sprintf("auto_postpone_period %d -> %d", board.AutoPostponePeriod, *spec.AutoPostponePeriod))
+	}
+	if len(details) > 0 {
+		// Restricting a board takes it away from everyone not listed.
+		restricting := update.AllAccess != nil && !*update.AllAccess
+		plan.add(Change{
+			Action:      ActionUpdate,
+			Kind:        KindBoard,
+			Board:       spec.Name,
+			Name:        spec.Name,
+			Detail:      strings.Join(details, ", "),
+			Destructive: restricting,
+			apply: func(ctx context.Context) error {
+				return client.UpdateBoard(ctx, ref.id, update)
+			},
+		})
+	}
+
+	restricted := !board.AllAccess
+	if spec.AllAccess != nil {
+		restricted = !*spec.AllAccess
+	}
+	if restricted && spec.Users != nil {
+		// The API cannot report who has access to a board, so the configured
+		// list is set outright on every run.
+		planAccess(plan, client, spec, ref, userIDs, true)
+	}
+
+	if spec.Columns == nil {
+		return nil
+	}
+	return planColumns(ctx, plan, client, spec, ref)
+}
+
+// planAccess gives a board the configured access list outright. It is
+// destructive for existing boards, whose current list is unknown, as anyone
+// not in the config loses access.
+func planAccess(plan *Plan, client *fizzy.Client, spec BoardSpec, ref *boardRef, userIDs map[string]string, destructive bool) {
+	detail := "revoke access from all users"
+	if len(spec.Users) > 0 {
+		detail = "grant access to " + strings.Join(spec.Users, ", ")
+	}
+	ids := accessUserIDs(spec, userIDs)
+	plan.add(Change{
+		Action:      ActionUpdate,
+		Kind:        KindAccess,
+		Board:       spec.Name,
+		Detail:      detail,
+		Destructive: destructive,
+		apply: func(ctx context.Context) error {
+			return client.SetBoardAccess(ctx, ref.id, ids)
+		},
+	})
+}
+
+func planColumns(ctx context.Context, plan *Plan, client *fizzy.Client, spec BoardSpec, ref *boardRef) error {
+	columns, err := client.ForBoard(ref.id).GetColumns(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list columns of board %q: %w", spec.Name, err)
+	}
+	live := make(map[string]fizzy.Column, len(columns))
+	for _, column := range columns {
+		live[column.Name] = column
+	}
+
+	wanted := make(map[string]bool, len(spec.Columns))
+	for _, column := range spec.Columns {
+		wanted[column.Name] = true
+
+		current, exists := live[column.Name]
+		if !exists {
+			planNewColumn(plan, client, spec.Name, ref, column)
+			continue
+		}
+
+		if column.Color == "" {
+			continue
+		}
+		color, _ := fizzy.ParseColor(column.Color)
+		if color == current.Color.Value {
+			continue
+		}
+		columnID := current.ID
+		plan.add(Change{
+			Action: ActionUpdate,
+			Kind:   KindColumn,
+			Board:  spec.Name,
+			Name:   column.Name,
+			Detail: fmt.Sprintf("color %s -> %s", current.Color.Value.Name(), color.Name()),
+			apply: func(ctx context.Context) error {
+				return client.ForBoard(ref.id).UpdateColumn(ctx, columnID, fizzy.UpdateColumnPayload{Color: &color})
+			},
+		})
+	}
+
+	var kept []string
+	for _, column := range columns {
+		if wanted[column.Name] {
+			kept = append(kept, column.Name)
+			continue
+		}
+		columnID := column.ID
+		plan.add(Change{
+			Action:      ActionDelete,
+			Destructive: true,
+			Kind:        KindColumn,
+			Board:       spec.Name,
+			Name:        column.Name,
+			apply: func(ctx context.Context) error {
+				return client.ForBoard(ref.id).DeleteColumn(ctx, columnID)
+			},
+		})
+	}
+
+	// New columns are appended, so the board ends up with the kept columns
+	// in their current order followed by the new ones.
+	final := kept
+	order := make([]string, len(spec.Columns))
+	for i, column := range spec.Columns {
+		order[i] = column.Name
+		if _, exists := live[column.Name]; !exists {
+			final = append(final, column.Name)
+		}
+	}
+	if !slices.Equal(final, order) {
+		plan.add(Change{
+			Action: ActionReorder,
+			Kind:   KindColumn,
+			Board:  spec.Name,
+			Detail: strings.Join(order, ", "),
+			apply: func(ctx context.Context) error {
+				return reorderColumns(ctx, client.ForBoard(ref.id), order)
+			},
+		})
+	}
+
+	return nil
+}
+
+// reorderColumns arranges a board's columns to match names, looking up the
+// IDs at apply time since some columns may have just been created.
+func reorderColumns(ctx context.Context, board *fizzy.Client, names []string) error {
+	columns, err := board.GetColumns(ctx)
+	if err != nil {
+		return err
+	}
+	ids := make(map[string]string, len(columns))
+	for _, column := range columns {
+		ids[column.Name] = column.ID
+	}
+
+	order := make([]string, 0, len(names))
+	for _, name := range names {
+		id, ok := ids[name]
+		if !ok {
+			return fmt.Errorf("column %q not found", name)
+		}
+		order = append(order, id)
+	}
+	return board.ReorderColumns(ctx, order)
+}
+
+func planNewColumn(plan *Plan, client *fizzy.Client, boardName string, ref *boardRef, column ColumnSpec) {
+	payload := fizzy.CreateColumnPayload{Name: column.Name}
+	detail := ""
+	if column.Color != "" {
+		color, _ := fizzy.ParseColor(column.Color)
+		payload.Color = &color
+		detail = color.Name()
+	}
+	plan.add(Change{
+		Action: ActionCreate,
+		Kind:   KindColumn,
+		Board:  boardName,
+		Name:   column.Name,
+		Detail: detail,
+		apply: func(ctx context.Context) error {
+			return client.ForBoard(ref.id).CreateColumn(ctx, payload)
+		},
+	})
+}
+
+// ApplyOptions configures Apply.
+type ApplyOptions struct {
+	// AllowDestructive permits plans that delete columns or revoke access
+	// to boards.
+	AllowDestructive bool
+
+	// Progress, if set, is called before each change is applied.
+	Progress func(Change)
+}
+
+// Apply carries out plan in order. Manual changes are skipped. A plan with
+// destructive changes is refused with ErrDestructive, before any change is
+// made, unless opts.AllowDestructive is set.
+func Apply(ctx context.Context, plan *Plan, opts ApplyOptions) error {
+	if plan.Destructive() && !opts.AllowDestructive {
+		var destructive []string
+		for _, ch := range plan.Changes {
+			if ch.Destructive {
+				destructive = append(destructive, ch.String())
+			}
+		}
+		return fmt.Errorf("%w:\n%s", ErrDestructive, strings.Join(destructive, "\n"))
+	}
+
+	for _, ch := range plan.Changes {
+		if ch.Manual || ch.apply == nil {
+			continue
+		}
+		if opts.Progress != nil {
+			opts.Progress(ch)
+		}
+		if err := ch.apply(ctx); err != nil {
+			return fmt.Errorf("failed to %s: %w", strings.TrimSpace(ch.String()[2:]), err)
+		}
+	}
+
+	return nil
+}
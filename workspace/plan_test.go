@@ -0,0 +1,306 @@
+package workspace
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+
+	fizzy "github.com/rogeriopvl/fizzy-go"
+)
+
+// newWorkspaceServer serves one existing board, "Engineering", with the
+// columns Review, Doing and Old, and records every mutating call. Columns
+// created on or deleted from that board are reflected in later listings.
+func newWorkspaceServer(t *testing.T, calls *[]string, bodies *[]map[string]any) *httptest.Server {
+	t.Helper()
+
+	var mu sync.Mutex
+	mux := http.NewServeMux()
+	encode := func(w http.ResponseWriter, v any) {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(v)
+	}
+	record := func(r *http.Request) map[string]any {
+		var body map[string]any
+		json.NewDecoder(r.Body).Decode(&body)
+		mu.Lock()
+		defer mu.Unlock()
+		*calls = append(*calls, r.Method+" "+strings.TrimPrefix(r.URL.Path, "/test-account"))
+		*bodies = append(*bodies, body)
+		return body
+	}
+
+	mux.HandleFunc("GET /test-account/boards", func(w http.ResponseWriter, r *http.Request) {
+		encode(w, []fizzy.Board{{ID: "board-1", Name: "Engineering", AllAccess: true}})
+	})
+	columns := []fizzy.Column{
+		{ID: "col-1", Name: "Review", Color: fizzy.ColorObject{Value: fizzy.ColorBlue}},
+		{ID: "col-2", Name: "Doing", Color: fizzy.ColorObject{Value: fizzy.ColorBlue}},
+		{ID: "col-3", Name: "Old", Color: fizzy.ColorObject{Value: fizzy.ColorBlue}},
+	}
+	mux.HandleFunc("GET /test-account/boards/board-1/columns", func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		encode(w, columns)
+	})
+	mux.HandleFunc("GET /test-account/users", func(w http.ResponseWriter, r *http.Request) {
+		encode(w, []fizzy.User{{ID: "user-1", Email: "ana@example.com"}})
+	})
+	mux.HandleFunc("GET /test-account/tags", func(w http.ResponseWriter, r *http.Request) {
+		encode(w, []fizzy.Tag{{ID: "tag-1", Title: "bug"}})
+	})
+	mux.HandleFunc("POST /test-account/boards", func(w http.ResponseWriter, r *http.Request) {
+		record(r)
+		w.Header().Set("Location", "/test-account/boards/board-2.json")
+		w.WriteHeader(http.StatusCreated)
+	})
+	mux.HandleFunc("POST /test-account/boards/{board}/columns", func(w http.ResponseWriter, r *http.Request) {
+		column := record(r)["column"].(map[string]any)
+
+		mu.Lock()
+		defer mu.Unlock()
+		if r.PathValue("board") == "board-1" {
+			columns = append(columns, fizzy.Column{ID: "col-new", Name: column["name"].(string)})
+		}
+		w.WriteHeader(http.StatusCreated)
+	})
+	mux.HandleFunc("DELETE /test-account/boards/board-1/columns/{id}", func(w http.ResponseWriter, r *http.Request) {
+		record(r)
+
+		mu.Lock()
+		defer mu.Unlock()
+		columns = slices.DeleteFunc(columns, func(c fizzy.Column) bool { return c.ID == r.PathValue("id") })
+		w.WriteHeader(http.StatusNoContent)
+	})
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		record(r)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return server
+}
+
+func testConfig(t *testing.T) *Config {
+	t.Helper()
+
+	cfg, err := Load(strings.NewReader(`
+boards:
+  - name: Engineering
+    all_access: false
+    users: [ana@example.com]
+    columns:
+      - name: Doing
+        color: Lime
+      - name: Review
+      - name: Done
+  - name: Ops
+    columns:
+      - name: Incoming
+tags: [bug, incident]
+`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return cfg
+}
+
+func TestDiff(t *testing.T) {
+	var calls []string
+	var bodies []map[string]any
+	server := newWorkspaceServer(t, &calls, &bodies)
+	client, _ := fizzy.NewClient("/test-account", "test-token", fizzy.WithBaseURL(server.URL))
+
+	plan, err := Diff(context.Background(), client, testConfig(t))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := strings.Join([]string{
+		`~ update board "Engineering": all_access true -> false`,
+		`~ update access on board "Engineering": grant access to ana@example.com`,
+		`~ update column "Doing" on board "Engineering": color Blue -> Lime`,
+		`+ create column "Done" on board "Engineering"`,
+		`- delete column "Old" on board "Engineering"`,
+		`~ reorder column on board "Engineering": Doing, Review, Done`,
+		`+ create board "Ops"`,
+		`+ create column "Incoming" on board "Ops"`,
+		`! create tag "incident": tags are created by tagging a card (manual)`,
+	}, "\n") + "\n"
+	if plan.String() != expected {
+		t.Errorf("unexpected plan:\n%s\nexpected:\n%s", plan, expected)
+	}
+	if !plan.Destructive() {
+		t.Error("expected plan to be destructive")
+	}
+	if len(calls) != 0 {
+		t.Errorf("expected Diff not to modify anything, got %v", calls)
+	}
+}
+
+func TestApply(t *testing.T) {
+	t.Run("refuses destructive plans", func(t *testing.T) {
+		var calls []string
+		var bodies []map[string]any
+		server := newWorkspaceServer(t, &calls, &bodies)
+		client, _ := fizzy.NewClient("/test-account", "test-token", fizzy.WithBaseURL(server.URL))
+
+		plan, err := Diff(context.Background(), client, testConfig(t))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		err = Apply(context.Background(), plan, ApplyOptions{})
+		if !errors.Is(err, ErrDestructive) {
+			t.Fatalf("expected ErrDestructive, got %v", err)
+		}
+		if len(calls) != 0 {
+			t.Errorf("expected no changes, got %v", calls)
+		}
+	})
+
+	t.Run("applies changes in order", func(t *testing.T) {
+		var calls []string
+		var bodies []map[string]any
+		server := newWorkspaceServer(t, &calls, &bodies)
+		client, _ := fizzy.NewClient("/test-account", "test-token", fizzy.WithBaseURL(server.URL))
+
+		plan, err := Diff(context.Background(), client, testConfig(t))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if err := Apply(context.Background(), plan, ApplyOptions{AllowDestructive: true}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		expected := []string{
+			"PUT /boards/board-1",
+			"PUT /boards/board-1",
+			"PUT /boards/board-1/columns/col-2",
+			"POST /boards/board-1/columns",
+			"DELETE /boards/board-1/columns/col-3",
+			"POST /boards/board-1/columns/col-2/left_position",
+			"POST /boards",
+			"POST /boards/board-2/columns",
+		}
+		if strings.Join(calls, "\n") != strings.Join(expected, "\n") {
+			t.Errorf("unexpected calls:\n%s", strings.Join(calls, "\n"))
+		}
+
+		access := bodies[1]["board"].(map[string]any)
+		if ids, _ := access["user_ids"].([]any); len(ids) != 1 || ids[0] != "user-1" {
+			t.Errorf("expected access for user-1, got %v", access)
+		}
+	})
+}
+
+func TestDiffAccess(t *testing.T) {
+	var updates []map[string]any
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /test-account/boards", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode([]fizzy.Board{{ID: "board-3", Name: "Finance"}})
+	})
+	mux.HandleFunc("GET /test-account/boards/board-3", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(fizzy.Board{ID: "board-3", Name: "Finance"})
+	})
+	mux.HandleFunc("GET /test-account/users", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode([]fizzy.User{
+			{ID: "user-1", Email: "ana@example.com"},
+			{ID: "user-2", Email: "bob@example.com"},
+			{ID: "user-3", Email: "cara@example.com"},
+		})
+	})
+	mux.HandleFunc("PUT /test-account/boards/board-3", func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]map[string]any
+		json.NewDecoder(r.Body).Decode(&body)
+		updates = append(updates, body["board"])
+		w.WriteHeader(http.StatusNoContent)
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	client, _ := fizzy.NewClient("/test-account", "test-token", fizzy.WithBaseURL(server.URL))
+	cfg, _ := Load(strings.NewReader("boards:\n  - name: Finance\n    users: [ana@example.com, cara@example.com]\n"))
+
+	plan, err := Diff(context.Background(), client, cfg)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := `~ update access on board "Finance": grant access to ana@example.com, cara@example.com` + "\n"
+	if plan.String() != expected {
+		t.Errorf("unexpected plan:\n%s", plan)
+	}
+	if !plan.Destructive() {
+		t.Error("expected replacing an unknown access list to be destructive")
+	}
+
+	if err := Apply(context.Background(), plan, ApplyOptions{AllowDestructive: true}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(updates) != 1 || fmt.Sprint(updates[0]["user_ids"]) != "[user-1 user-3]" {
+		t.Errorf("unexpected updates: %v", updates)
+	}
+}
+
+func TestApplyRevokingAccess(t *testing.T) {
+	var updates []map[string]any
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /test-account/boards", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode([]fizzy.Board{{ID: "board-3", Name: "Finance"}})
+	})
+	mux.HandleFunc("GET /test-account/boards/board-3", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(fizzy.Board{ID: "board-3", Name: "Finance"})
+	})
+	mux.HandleFunc("GET /test-account/users", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode([]fizzy.User{{ID: "user-1", Email: "ana@example.com"}, {ID: "user-2", Email: "bob@example.com"}})
+	})
+	mux.HandleFunc("PUT /test-account/boards/board-3", func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]map[string]any
+		json.NewDecoder(r.Body).Decode(&body)
+		updates = append(updates, body["board"])
+		w.WriteHeader(http.StatusNoContent)
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	client, _ := fizzy.NewClient("/test-account", "test-token", fizzy.WithBaseURL(server.URL))
+	cfg, _ := Load(strings.NewReader("boards:\n  - name: Finance\n    users: []\n"))
+
+	plan, err := Diff(context.Background(), client, cfg)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := `~ update access on board "Finance": revoke access from all users` + "\n"
+	if plan.String() != expected {
+		t.Errorf("unexpected plan:\n%s", plan)
+	}
+	if !plan.Destructive() {
+		t.Error("expected revoking access to be destructive")
+	}
+
+	err = Apply(context.Background(), plan, ApplyOptions{})
+	if !errors.Is(err, ErrDestructive) || !strings.Contains(err.Error(), "revoke access") {
+		t.Fatalf("expected ErrDestructive listing the revocation, got %v", err)
+	}
+	if len(updates) != 0 {
+		t.Errorf("expected no changes, got %v", updates)
+	}
+
+	if err := Apply(context.Background(), plan, ApplyOptions{AllowDestructive: true}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(updates) != 1 || fmt.Sprint(updates[0]["user_ids"]) != "[]" {
+		t.Errorf("unexpected updates: %v", updates)
+	}
+}
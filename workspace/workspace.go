@@ -0,0 +1,101 @@
+// Package workspace manages a Fizzy account's boards declaratively.
+//
+// A Config describes the desired boards, their columns and access, and the
+// tags that should exist. Diff compares it with the live account and returns
+// a Plan of changes, which Apply carries out:
+//
+//	cfg, err := workspace.Load(file)
+//	plan, err := workspace.Diff(ctx, client, cfg)
+//	fmt.Print(plan)
+//	err = workspace.Apply(ctx, plan, workspace.ApplyOptions{})
+//
+// Boards are matched by name and columns by name within their board. Boards
+// missing from the config are left untouched; columns missing from a
+// board's column list are deleted and users missing from its users list lose
+// access, which Apply refuses to do unless destructive changes are
+// explicitly allowed.
+package workspace
+
+import (
+	"fmt"
+	"io"
+
+	fizzy "github.com/rogeriopvl/fizzy-go"
+	"gopkg.in/yaml.v3"
+)
+
+// Config is the desired state of an account.
+type Config struct {
+	Boards []BoardSpec `json:"boards" yaml:"boards"`
+
+	// Tags lists tags expected to exist. Fizzy creates tags implicitly when
+	// cards are tagged, so missing tags are reported but not created.
+	Tags []string `json:"tags,omitempty" yaml:"tags,omitempty"`
+}
+
+// BoardSpec is the desired state of one board. Nil fields are not managed.
+type BoardSpec struct {
+	Name               string `json:"name" yaml:"name"`
+	AllAccess          *bool  `json:"all_access,omitempty" yaml:"all_access,omitempty"`
+	AutoPostponePeriod *int   `json:"auto_postpone_period,omitempty" yaml:"auto_postpone_period,omitempty"`
+
+	// Users lists the email addresses of users with access to the board
+	// when AllAccess is false.
+	Users []string `json:"users,omitempty" yaml:"users,omitempty"`
+
+	// Columns lists the board's columns in order. When nil the board's
+	// columns are not managed; when empty every column is deleted.
+	Columns []ColumnSpec `json:"columns,omitempty" yaml:"columns,omitempty"`
+}
+
+// ColumnSpec is the desired state of a column. Color takes a name such as
+// "Lime" or a CSS value, see fizzy.ParseColor.
+type ColumnSpec struct {
+	Name  string `json:"name" yaml:"name"`
+	Color string `json:"color,omitempty" yaml:"color,omitempty"`
+}
+
+// Load decodes a Config from YAML or JSON and validates it.
+func Load(r io.Reader) (*Config, error) {
+	var cfg Config
+	if err := yaml.NewDecoder(r).Decode(&cfg); err != nil && err != io.EOF {
+		return nil, fmt.Errorf("failed to decode workspace config: %w", err)
+	}
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	return &cfg, nil
+}
+
+// Validate checks for unnamed or duplicate boards and columns and unknown
+// colors.
+func (cfg *Config) Validate() error {
+	boards := make(map[string]bool, len(cfg.Boards))
+	for i, board := range cfg.Boards {
+		if board.Name == "" {
+			return fmt.Errorf("workspace config: board %d has no name", i+1)
+		}
+		if boards[board.Name] {
+			return fmt.Errorf("workspace config: board %q is defined twice", board.Name)
+		}
+		boards[board.Name] = true
+
+		columns := make(map[string]bool, len(board.Columns))
+		for j, column := range board.Columns {
+			if column.Name == "" {
+				return fmt.Errorf("workspace config: column %d of board %q has no name", j+1, board.Name)
+			}
+			if columns[column.Name] {
+				return fmt.Errorf("workspace config: column %q is defined twice on board %q", column.Name, board.Name)
+			}
+			columns[column.Name] = true
+
+			if column.Color != "" {
+				if _, ok := fizzy.ParseColor(column.Color); !ok {
+					return fmt.Errorf("workspace config: column %q has unknown color %q", column.Name, column.Color)
+				}
+			}
+		}
+	}
+	return nil
+}
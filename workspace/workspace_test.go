@@ -0,0 +1,59 @@
+package workspace
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestLoad(t *testing.T) {
+	t.Run("parses YAML", func(t *testing.T) {
+		cfg, err := Load(strings.NewReader(`
+boards:
+  - name: Engineering
+    all_access: false
+    users: [ana@example.com]
+    columns:
+      - name: Doing
+        color: Lime
+      - name: Review
+tags: [bug]
+`))
+
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(cfg.Boards) != 1 || cfg.Boards[0].AllAccess == nil || *cfg.Boards[0].AllAccess {
+			t.Errorf("unexpected boards: %+v", cfg.Boards)
+		}
+		if len(cfg.Boards[0].Columns) != 2 || cfg.Boards[0].Columns[0].Color != "Lime" {
+			t.Errorf("unexpected columns: %+v", cfg.Boards[0].Columns)
+		}
+		if len(cfg.Tags) != 1 || cfg.Tags[0] != "bug" {
+			t.Errorf("unexpected tags: %v", cfg.Tags)
+		}
+	})
+
+	t.Run("accepts an empty document", func(t *testing.T) {
+		cfg, err := Load(strings.NewReader(""))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(cfg.Boards) != 0 {
+			t.Errorf("expected no boards, got %+v", cfg.Boards)
+		}
+	})
+
+	t.Run("rejects duplicate boards", func(t *testing.T) {
+		_, err := Load(strings.NewReader("boards:\n  - name: A\n  - name: A\n"))
+		if err == nil {
+			t.Fatal("expected error, got nil")
+		}
+	})
+
+	t.Run("rejects unknown colors", func(t *testing.T) {
+		_, err := Load(strings.NewReader("boards:\n  - name: A\n    columns:\n      - name: Doing\n        color: Orange\n"))
+		if err == nil {
+			t.Fatal("expected error, got nil")
+		}
+	})
+}